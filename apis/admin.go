@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"github.com/pkg/errors"
 	"github.com/subzerobo/go-apicurio-sdk/client"
 	"github.com/subzerobo/go-apicurio-sdk/models"
@@ -25,25 +24,51 @@ func NewAdminAPI(client *client.Client) *AdminAPI {
 // GET /admin/rules
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Global-rules/operation/listGlobalRules
 func (api *AdminAPI) ListGlobalRules(ctx context.Context) ([]models.Rule, error) {
-	url := fmt.Sprintf("%s/admin/rules", api.Client.BaseURL)
+	url := api.Client.URL("admin", "rules")
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var rules []models.Rule
-	if err := handleResponse(resp, http.StatusOK, &rules); err != nil {
+	if err := handleResponse(resp, http.StatusOK, &rules, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
 
 	return rules, nil
 }
 
+// ListGlobalRulesWithConfig returns the configured global rules together with their levels, fetching
+// the configuration of each rule concurrently. Rules are returned in the order ListGlobalRules lists them.
+func (api *AdminAPI) ListGlobalRulesWithConfig(ctx context.Context) ([]models.GlobalRuleResponse, error) {
+	rules, err := api.ListGlobalRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]models.GlobalRuleResponse, len(rules))
+	errs := forEachBounded(ctx, len(rules), bulkConcurrency, func(ctx context.Context, i int) error {
+		level, err := api.GetGlobalRule(ctx, rules[i])
+		results[i] = models.GlobalRuleResponse{RuleType: rules[i], Config: level}
+		return err
+	})
+	for i, err := range errs {
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get global rule %s", rules[i])
+		}
+	}
+
+	return results, nil
+}
+
 // CreateGlobalRule Creates a new global rule.
 // POST /admin/rules
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Global-rules/operation/createGlobalRule
 func (api *AdminAPI) CreateGlobalRule(ctx context.Context, rule models.Rule, level models.RuleLevel) error {
-	url := fmt.Sprintf("%s/admin/rules", api.Client.BaseURL)
+	if err := validateRule(rule); err != nil {
+		return err
+	}
+	url := api.Client.URL("admin", "rules")
 
 	// Prepare the request body
 	body := models.CreateUpdateGlobalRuleRequest{
@@ -55,34 +80,37 @@ func (api *AdminAPI) CreateGlobalRule(ctx context.Context, rule models.Rule, lev
 		return err
 	}
 
-	return handleResponse(resp, http.StatusNoContent, nil)
+	return handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding)
 }
 
 // DeleteAllGlobalRule Adds a rule to the list of globally configured rules.
 // DELETE /admin/rules
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Global-rules/operation/deleteAllGlobalRules
 func (api *AdminAPI) DeleteAllGlobalRule(ctx context.Context) error {
-	url := fmt.Sprintf("%s/admin/rules", api.Client.BaseURL)
+	url := api.Client.URL("admin", "rules")
 	resp, err := api.executeRequest(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return err
 	}
 
-	return handleResponse(resp, http.StatusNoContent, nil)
+	return handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding)
 }
 
 // GetGlobalRule Returns information about the named globally configured rule.
 // GET /admin/rules/{rule}
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Global-rules/operation/getGlobalRuleConfig
 func (api *AdminAPI) GetGlobalRule(ctx context.Context, rule models.Rule) (models.RuleLevel, error) {
-	url := fmt.Sprintf("%s/admin/rules/%s", api.Client.BaseURL, rule)
+	if err := validateRule(rule); err != nil {
+		return "", err
+	}
+	url := api.Client.URL("admin", "rules", string(rule))
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return "", err
 	}
 
 	var globalRule models.GlobalRuleResponse
-	if err := handleResponse(resp, http.StatusOK, &globalRule); err != nil {
+	if err := handleResponse(resp, http.StatusOK, &globalRule, api.Client.StrictDecoding); err != nil {
 		return "", err
 	}
 
@@ -93,7 +121,10 @@ func (api *AdminAPI) GetGlobalRule(ctx context.Context, rule models.Rule) (model
 // PUT /admin/rules/{rule}
 // See https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Global-rules/operation/updateGlobalRuleConfig
 func (api *AdminAPI) UpdateGlobalRule(ctx context.Context, rule models.Rule, level models.RuleLevel) error {
-	url := fmt.Sprintf("%s/admin/rules/%s", api.Client.BaseURL, rule)
+	if err := validateRule(rule); err != nil {
+		return err
+	}
+	url := api.Client.URL("admin", "rules", string(rule))
 
 	// Prepare the request body
 	body := models.CreateUpdateGlobalRuleRequest{
@@ -106,7 +137,7 @@ func (api *AdminAPI) UpdateGlobalRule(ctx context.Context, rule models.Rule, lev
 	}
 
 	var globalRule models.GlobalRuleResponse
-	if err := handleResponse(resp, http.StatusOK, &globalRule); err != nil {
+	if err := handleUpdateResponse(resp, &globalRule, api.Client.StrictDecoding); err != nil {
 		return err
 	}
 
@@ -117,13 +148,16 @@ func (api *AdminAPI) UpdateGlobalRule(ctx context.Context, rule models.Rule, lev
 // DELETE /admin/rules/{rule}
 // See https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Global-rules/operation/deleteGlobalRule
 func (api *AdminAPI) DeleteGlobalRule(ctx context.Context, rule models.Rule) error {
-	url := fmt.Sprintf("%s/admin/rules/%s", api.Client.BaseURL, rule)
+	if err := validateRule(rule); err != nil {
+		return err
+	}
+	url := api.Client.URL("admin", "rules", string(rule))
 	resp, err := api.executeRequest(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return err
 	}
 
-	return handleResponse(resp, http.StatusNoContent, nil)
+	return handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding)
 }
 
 // executeRequest handles the creation and execution of an HTTP request.
@@ -161,7 +195,7 @@ func (api *AdminAPI) executeRequest(ctx context.Context, method, url string, bod
 	// Execute the request
 	resp, err := api.Client.Do(req)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to execute HTTP request")
+		return nil, requestError(ctx, err)
 	}
 
 	return resp, nil
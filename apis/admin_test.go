@@ -68,6 +68,62 @@ func TestRulesAPI_ListGlobalRules(t *testing.T) {
 	})
 }
 
+func TestRulesAPI_ListGlobalRulesWithConfig(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		levels := map[models.Rule]models.RuleLevel{
+			models.RuleValidity:      models.ValidityLevelFull,
+			models.RuleCompatibility: models.CompatibilityLevelBackward,
+			models.RuleIntegrity:     models.IntegrityLevelRefsExist,
+		}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			w.WriteHeader(http.StatusOK)
+
+			if r.URL.Path == "/admin/rules" {
+				err := json.NewEncoder(w).Encode([]models.Rule{models.RuleValidity, models.RuleCompatibility, models.RuleIntegrity})
+				assert.NoError(t, err)
+				return
+			}
+			rule := models.Rule(r.URL.Path[len("/admin/rules/"):])
+			err := json.NewEncoder(w).Encode(models.GlobalRuleResponse{RuleType: rule, Config: levels[rule]})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewAdminAPI(mockClient)
+
+		result, err := api.ListGlobalRulesWithConfig(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []models.GlobalRuleResponse{
+			{RuleType: models.RuleValidity, Config: models.ValidityLevelFull},
+			{RuleType: models.RuleCompatibility, Config: models.CompatibilityLevelBackward},
+			{RuleType: models.RuleIntegrity, Config: models.IntegrityLevelRefsExist},
+		}, result)
+	})
+
+	t.Run("Rule Fetch Fails", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/admin/rules" {
+				err := json.NewEncoder(w).Encode([]models.Rule{models.RuleValidity})
+				assert.NoError(t, err)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			err := json.NewEncoder(w).Encode(models.APIError{Status: http.StatusNotFound, Title: TitleNotFound})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewAdminAPI(mockClient)
+
+		result, err := api.ListGlobalRulesWithConfig(context.Background())
+		assert.True(t, models.IsNotFound(err))
+		assert.Nil(t, result)
+	})
+}
+
 func TestRulesAPI_CreateGlobalRule(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -299,6 +355,20 @@ func TestRulesAPI_UpdateGlobalRule(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("No Content", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewAdminAPI(mockClient)
+
+		err := api.UpdateGlobalRule(context.Background(), models.RuleValidity, models.ValidityLevelFull)
+		assert.NoError(t, err)
+	})
+
 	t.Run("NotFound", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			assert.Contains(t, r.URL.Path, "/admin/rules/")
@@ -414,3 +484,24 @@ func TestRulesAPI_DeleteGlobalRule(t *testing.T) {
 		assert.Equal(t, TitleInternalServerError, apiErr.Title)
 	})
 }
+
+func TestRulesAPI_UnknownRule(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewAdminAPI(mockClient)
+	ctx := context.Background()
+	rule := models.Rule("SECURITY")
+
+	err := api.CreateGlobalRule(ctx, rule, models.ValidityLevelFull)
+	assert.True(t, errors.Is(err, apis.ErrInvalidArgument))
+	_, err = api.GetGlobalRule(ctx, rule)
+	assert.True(t, errors.Is(err, apis.ErrInvalidArgument))
+	err = api.UpdateGlobalRule(ctx, rule, models.ValidityLevelFull)
+	assert.True(t, errors.Is(err, apis.ErrInvalidArgument))
+	err = api.DeleteGlobalRule(ctx, rule)
+	assert.True(t, errors.Is(err, apis.ErrInvalidArgument))
+}
@@ -4,16 +4,19 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"github.com/pkg/errors"
 	"github.com/subzerobo/go-apicurio-sdk/client"
 	"github.com/subzerobo/go-apicurio-sdk/models"
 	"io"
 	"net/http"
+	"strconv"
 )
 
 type ArtifactsAPI struct {
 	Client *client.Client
+
+	// DefaultGroup, when set, is used by the methods taking a group ID when they are called with an empty one.
+	DefaultGroup string
 }
 
 func NewArtifactsAPI(client *client.Client) *ArtifactsAPI {
@@ -23,52 +26,124 @@ func NewArtifactsAPI(client *client.Client) *ArtifactsAPI {
 }
 
 var (
-	ErrArtifactNotFound = errors.New("artifact not found")
-	ErrMethodNotAllowed = errors.New("method not allowed or disabled on the server")
-	ErrInvalidInput     = errors.New("input must be between 1 and 512 characters")
+	ErrArtifactNotFound   = errors.New("artifact not found")
+	ErrMethodNotAllowed   = errors.New("method not allowed or disabled on the server")
+	ErrInvalidInput       = errors.New("input must be between 1 and 512 characters")
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrPreconditionFailed = errors.New("precondition failed: the resource was modified concurrently")
+
+	ErrVersionDeletionDisabled = errors.New("version deletion is disabled on the server; enable it with registry.rest.artifact.deletion.enabled=true")
+	ErrRegistryUnavailable     = errors.New("registry is not ready")
+	ErrNoGroupInContext        = errors.New("no group set in context; use ContextWithGroup")
+	ErrTooManyVersions         = errors.New("artifact has too many versions")
 )
 
 // SearchArtifacts - Search for artifacts using the given filter parameters.
 // Search for artifacts using the given filter parameters.
+// When params.GroupIDs is set, each group is searched in turn and the results are merged without duplicates.
 // See:
 func (api *ArtifactsAPI) SearchArtifacts(ctx context.Context, params *models.SearchArtifactsParams) (*[]models.SearchedArtifact, error) {
+	if params != nil && len(params.GroupIDs) > 0 {
+		return api.searchArtifactsInGroups(ctx, params)
+	}
+
 	query := ""
 	if params != nil {
+		if err := validateParams(params); err != nil {
+			return nil, err
+		}
 		query = "?" + params.ToQuery().Encode()
 	}
 
-	url := fmt.Sprintf("%s/search/artifacts%s", api.Client.BaseURL, query)
+	url := api.Client.URL("search", "artifacts") + query
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var result models.SearchArtifactsAPIResponse
-	if err := handleResponse(resp, http.StatusOK, &result); err != nil {
+	if err := handleResponse(resp, http.StatusOK, &result, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
 
 	return &result.Artifacts, nil
 }
 
+// searchArtifactsInGroups runs SearchArtifacts once per group of params.GroupIDs, since the registry
+// filters on a single group, and merges the results in group order.
+func (api *ArtifactsAPI) searchArtifactsInGroups(ctx context.Context, params *models.SearchArtifactsParams) (*[]models.SearchedArtifact, error) {
+	type key struct{ groupID, artifactID string }
+	seen := map[key]bool{}
+	merged := []models.SearchedArtifact{}
+
+	for _, groupID := range params.GroupIDs {
+		groupParams := *params
+		groupParams.GroupID = groupID
+		groupParams.GroupIDs = nil
+
+		artifacts, err := api.SearchArtifacts(ctx, &groupParams)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to search group %s", groupID)
+		}
+		for _, artifact := range *artifacts {
+			k := key{artifact.GroupId, artifact.ArtifactId}
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			merged = append(merged, artifact)
+		}
+	}
+
+	return &merged, nil
+}
+
 // SearchArtifactsByContent searches for artifacts that match the provided content.
 // Returns a paginated list of all artifacts with at least one version that matches the posted content.
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifacts/operation/searchArtifactsByContent
 func (api *ArtifactsAPI) SearchArtifactsByContent(ctx context.Context, content []byte, params *models.SearchArtifactsByContentParams) (*[]models.SearchedArtifact, error) {
+	return api.searchArtifactsByContent(ctx, content, nil, params)
+}
+
+// SearchArtifactsByContentWithRefs searches for artifacts that match the provided content and its references.
+// When refs is not empty, the content and references are posted as a structured JSON body so that the server
+// can resolve schemas that import other artifacts (e.g. Protobuf or Avro); otherwise it behaves like SearchArtifactsByContent.
+// See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifacts/operation/searchArtifactsByContent
+func (api *ArtifactsAPI) SearchArtifactsByContentWithRefs(ctx context.Context, content []byte, refs []models.ArtifactReference, params *models.SearchArtifactsByContentParams) (*[]models.SearchedArtifact, error) {
+	return api.searchArtifactsByContent(ctx, content, refs, params)
+}
+
+func (api *ArtifactsAPI) searchArtifactsByContent(ctx context.Context, content []byte, refs []models.ArtifactReference, params *models.SearchArtifactsByContentParams) (*[]models.SearchedArtifact, error) {
 	// Convert params to query string
 	query := ""
 	if params != nil {
 		query = "?" + params.ToQuery().Encode()
+
+		if params.CanonicalizeLocally {
+			canonical, err := models.Canonicalize(models.ArtifactType(params.ArtifactType), content)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to canonicalize content")
+			}
+			content = canonical
+		}
 	}
 
-	url := fmt.Sprintf("%s/search/artifacts%s", api.Client.BaseURL, query)
-	resp, err := api.executeRequest(ctx, http.MethodPost, url, content)
+	var body interface{} = content
+	if len(refs) > 0 {
+		body = models.SearchContentRequest{
+			Content:    string(content),
+			References: refs,
+		}
+	}
+
+	url := api.Client.URL("search", "artifacts") + query
+	resp, err := api.executeRequest(ctx, http.MethodPost, url, body)
 	if err != nil {
 		return nil, err
 	}
 
 	var result models.SearchArtifactsAPIResponse
-	if err := handleResponse(resp, http.StatusOK, &result); err != nil {
+	if err := handleResponse(resp, http.StatusOK, &result, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
 
@@ -76,16 +151,22 @@ func (api *ArtifactsAPI) SearchArtifactsByContent(ctx context.Context, content [
 }
 
 // ListArtifactReferences Returns a list containing all the artifact references using the artifact content ID.
+// Set params.RefType to choose between OUTBOUND (the default) and INBOUND references.
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifacts/operation/referencesByContentId
-func (api *ArtifactsAPI) ListArtifactReferences(ctx context.Context, contentID int64) (*[]models.ArtifactReference, error) {
-	url := fmt.Sprintf("%s/ids/contentId/%d/references", api.Client.BaseURL, contentID)
+func (api *ArtifactsAPI) ListArtifactReferences(ctx context.Context, contentID int64, params *models.ListArtifactReferencesByGlobalIDParams) (*[]models.ArtifactReference, error) {
+	query := ""
+	if params != nil {
+		query = "?" + params.ToQuery().Encode()
+	}
+
+	url := api.Client.URL("ids", "contentIds", strconv.FormatInt(contentID, 10), "references") + query
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var references []models.ArtifactReference
-	if err := handleResponse(resp, http.StatusOK, &references); err != nil {
+	if err := handleResponse(resp, http.StatusOK, &references, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
 
@@ -100,14 +181,14 @@ func (api *ArtifactsAPI) ListArtifactReferencesByGlobalID(ctx context.Context, g
 		query = "?" + params.ToQuery().Encode()
 	}
 
-	url := fmt.Sprintf("%s/ids/globalIds/%d/references%s", api.Client.BaseURL, globalID, query)
+	url := api.Client.URL("ids", "globalIds", strconv.FormatInt(globalID, 10), "references") + query
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var references []models.ArtifactReference
-	if err := handleResponse(resp, http.StatusOK, &references); err != nil {
+	if err := handleResponse(resp, http.StatusOK, &references, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
 
@@ -117,40 +198,94 @@ func (api *ArtifactsAPI) ListArtifactReferencesByGlobalID(ctx context.Context, g
 // ListArtifactReferencesByHash Returns a list containing all the artifact references using the artifact content hash.
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifacts/operation/referencesByContentHash
 func (api *ArtifactsAPI) ListArtifactReferencesByHash(ctx context.Context, contentHash string) (*[]models.ArtifactReference, error) {
-	url := fmt.Sprintf("%s/ids/contentHashes/%s/references", api.Client.BaseURL, contentHash)
+	url := api.Client.URL("ids", "contentHashes", contentHash, "references")
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var references []models.ArtifactReference
-	if err := handleResponse(resp, http.StatusOK, &references); err != nil {
+	if err := handleResponse(resp, http.StatusOK, &references, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
 
 	return &references, nil
 }
 
+// ResolveReferencesClosure fetches the content of every artifact version transitively referenced by the
+// version with the given global ID, keyed by "groupId:artifactId:version". Each version is fetched once,
+// so reference cycles terminate. A reference without a group ID points into the registry's "default" group.
+func (api *ArtifactsAPI) ResolveReferencesClosure(ctx context.Context, globalID int64) (map[string]models.ArtifactContent, error) {
+	refs, err := api.ListArtifactReferencesByGlobalID(ctx, globalID, &models.ListArtifactReferencesByGlobalIDParams{RefType: models.OutBound})
+	if err != nil {
+		return nil, err
+	}
+
+	versions := NewVersionsAPI(api.Client)
+	closure := make(map[string]models.ArtifactContent)
+	pending := *refs
+	for len(pending) > 0 {
+		ref := pending[0]
+		pending = pending[1:]
+		ref.GroupID = orDefaultGroup(ref.GroupID, registryDefaultGroup)
+
+		key := ref.GroupID + ":" + ref.ArtifactID + ":" + ref.Version
+		if _, seen := closure[key]; seen {
+			continue
+		}
+
+		content, err := versions.GetArtifactVersionContent(ctx, ref.GroupID, ref.ArtifactID, ref.Version, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch referenced artifact %s", key)
+		}
+		closure[key] = *content
+
+		nested, err := versions.GetArtifactVersionReferences(ctx, ref.GroupID, ref.ArtifactID, ref.Version, &models.ArtifactVersionReferencesParams{RefType: models.OutBound})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list references of %s", key)
+		}
+		pending = append(pending, *nested...)
+	}
+
+	return closure, nil
+}
+
 // ListArtifactsInGroup lists all artifacts in a specified group.
+// With params.LabelFilters set, the artifacts are listed by a search restricted to the group.
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifacts/operation/referencesByContentHash
 func (api *ArtifactsAPI) ListArtifactsInGroup(ctx context.Context, groupID string, params *models.ListArtifactsInGroupParams) (*models.ListArtifactsResponse, error) {
+	groupID = orDefaultGroup(groupID, api.DefaultGroup)
 	if err := validateInput(groupID, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return nil, err
 	}
 
 	query := ""
 	if params != nil {
+		if err := validateParams(params); err != nil {
+			return nil, err
+		}
 		query = "?" + params.ToQuery().Encode()
 	}
 
-	url := fmt.Sprintf("%s/groups/%s/artifacts%s", api.Client.BaseURL, groupID, query)
+	url := api.Client.URL("groups", groupID, "artifacts") + query
+	if params != nil && len(params.LabelFilters) > 0 {
+		searchParams := models.SearchArtifactsParams{
+			GroupID:      groupID,
+			Offset:       params.Offset,
+			Limit:        params.Limit,
+			Order:        params.Order,
+			OrderBy:      params.OrderBy,
+			LabelFilters: params.LabelFilters,
+		}
+		url = api.Client.URL("search", "artifacts") + "?" + searchParams.ToQuery().Encode()
+	}
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var result models.ListArtifactsResponse
-	if err := handleResponse(resp, http.StatusOK, &result); err != nil {
+	if err := handleResponse(resp, http.StatusOK, &result, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
 
@@ -161,11 +296,12 @@ func (api *ArtifactsAPI) ListArtifactsInGroup(ctx context.Context, groupID strin
 // This content hash may be shared by multiple artifact versions in the case where the artifact versions have identical content.
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifacts/operation/getContentByHash
 func (api *ArtifactsAPI) GetArtifactContentByHash(ctx context.Context, contentHash string) (*models.ArtifactContent, error) {
-	url := fmt.Sprintf("%s/ids/contentHashes/%s", api.Client.BaseURL, contentHash)
+	url := api.Client.URL("ids", "contentHashes", contentHash)
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
+	defer drainAndClose(resp.Body)
 
 	if resp.StatusCode == http.StatusNotFound {
 		return nil, errors.Wrapf(ErrArtifactNotFound, "content hash: %s", contentHash)
@@ -197,15 +333,36 @@ func (api *ArtifactsAPI) GetArtifactContentByHash(ctx context.Context, contentHa
 	}, nil
 }
 
+// ContentExistsByHash reports whether the registry holds content with the given SHA-256 hash, without
+// downloading it. It issues a HEAD request, so a failure other than 404 is reported with the status only.
+func (api *ArtifactsAPI) ContentExistsByHash(ctx context.Context, contentHash string) (bool, error) {
+	url := api.Client.URL("ids", "contentHashes", contentHash)
+	resp, err := api.executeRequest(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return false, err
+	}
+	defer drainAndClose(resp.Body)
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, &models.APIError{Status: resp.StatusCode, Title: http.StatusText(resp.StatusCode)}
+	}
+}
+
 // GetArtifactContentByID Gets the content for an artifact version in the registry using the unique content identifier for that content
 // This content ID may be shared by multiple artifact versions in the case where the artifact versions are identical.
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifacts/operation/getContentById
 func (api *ArtifactsAPI) GetArtifactContentByID(ctx context.Context, contentID int64) (*models.ArtifactContent, error) {
-	url := fmt.Sprintf("%s/ids/contentIds/%d", api.Client.BaseURL, contentID)
+	url := api.Client.URL("ids", "contentIds", strconv.FormatInt(contentID, 10))
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
+	defer drainAndClose(resp.Body)
 
 	if resp.StatusCode == http.StatusNotFound {
 		return nil, errors.Wrapf(ErrArtifactNotFound, "content ID: %d", contentID)
@@ -241,23 +398,49 @@ func (api *ArtifactsAPI) GetArtifactContentByID(ctx context.Context, contentID i
 // Deletes all the artifacts that exist in a given group.
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifacts/operation/deleteArtifactsInGroup
 func (api *ArtifactsAPI) DeleteArtifactsInGroup(ctx context.Context, groupID string) error {
+	groupID = orDefaultGroup(groupID, api.DefaultGroup)
 	if err := validateInput(groupID, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return err
 	}
 
-	url := fmt.Sprintf("%s/groups/%s/artifacts", api.Client.BaseURL, groupID)
+	url := api.Client.URL("groups", groupID, "artifacts")
+	resp, err := api.executeRequest(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return err
+	}
+
+	return handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding)
+}
+
+// PurgeGroup deletes all artifacts in a group like DeleteArtifactsInGroup and, with withGroup set, the
+// then empty group itself. The group is not deleted when deleting its artifacts fails.
+// See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Groups/operation/deleteGroupById
+func (api *ArtifactsAPI) PurgeGroup(ctx context.Context, groupID string, withGroup bool) error {
+	groupID = orDefaultGroup(groupID, api.DefaultGroup)
+	if err := api.DeleteArtifactsInGroup(ctx, groupID); err != nil {
+		return err
+	}
+	if !withGroup {
+		return nil
+	}
+
+	url := api.Client.URL("groups", groupID)
 	resp, err := api.executeRequest(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return err
 	}
 
-	return handleResponse(resp, http.StatusNoContent, nil)
+	if err := handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding); err != nil {
+		return errors.Wrapf(err, "artifacts of group %s were deleted but deleting the group failed", groupID)
+	}
+	return nil
 }
 
 // DeleteArtifact deletes a specific artifact identified by groupId and artifactId.
 // Deletes an artifact completely, resulting in all versions of the artifact also being deleted. This may fail for one of the following reasons:
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifacts/operation/deleteArtifact
 func (api *ArtifactsAPI) DeleteArtifact(ctx context.Context, groupID, artifactId string) error {
+	groupID = orDefaultGroup(groupID, api.DefaultGroup)
 	if err := validateInput(groupID, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return err
 	}
@@ -265,66 +448,400 @@ func (api *ArtifactsAPI) DeleteArtifact(ctx context.Context, groupID, artifactId
 		return err
 	}
 
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s", api.Client.BaseURL, groupID, artifactId)
+	url := api.Client.URL("groups", groupID, "artifacts", artifactId)
 	resp, err := api.executeRequest(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return err
 	}
 
 	if resp.StatusCode == http.StatusMethodNotAllowed {
+		drainAndClose(resp.Body)
 		return ErrMethodNotAllowed
 	}
 
-	return handleResponse(resp, http.StatusNoContent, nil)
+	return handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding)
+}
+
+// ArtifactExists reports whether the artifact exists, by fetching its metadata. A 404 is reported as false
+// rather than as an error; any other failure is returned as an error.
+func (api *ArtifactsAPI) ArtifactExists(ctx context.Context, groupID, artifactId string) (bool, error) {
+	groupID = orDefaultGroup(groupID, api.DefaultGroup)
+	if err := validateInput(groupID, regexGroupIDArtifactID, "Group ID"); err != nil {
+		return false, err
+	}
+	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
+		return false, err
+	}
+
+	url := api.Client.URL("groups", groupID, "artifacts", artifactId)
+	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		drainAndClose(resp.Body)
+		return true, nil
+	case http.StatusNotFound:
+		drainAndClose(resp.Body)
+		return false, nil
+	default:
+		return false, handleResponse(resp, http.StatusOK, nil, api.Client.StrictDecoding)
+	}
+}
+
+// GetArtifact returns the metadata of an artifact together with the content of its latest version,
+// fetching both concurrently. The content's ArtifactType is taken from the metadata. If either request
+// fails, the first error is returned.
+func (api *ArtifactsAPI) GetArtifact(ctx context.Context, groupID, artifactId string) (*models.ArtifactMetadata, *models.ArtifactContent, error) {
+	groupID = orDefaultGroup(groupID, api.DefaultGroup)
+	var (
+		metadata *models.ArtifactMetadata
+		content  *models.ArtifactContent
+	)
+	errs := forEachBounded(ctx, 2, 2, func(ctx context.Context, i int) error {
+		var err error
+		switch i {
+		case 0:
+			metadata, err = NewMetadataAPI(api.Client).GetArtifactMetadata(ctx, groupID, artifactId)
+			return errors.Wrap(err, "failed to get artifact metadata")
+		default:
+			content, err = NewVersionsAPI(api.Client).GetArtifactVersionContent(ctx, groupID, artifactId, models.LatestVersion().String(), nil)
+			return errors.Wrap(err, "failed to get latest version content")
+		}
+	})
+	for _, err := range errs {
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if content.ArtifactType == "" {
+		content.ArtifactType = models.ArtifactType(metadata.ArtifactType)
+	}
+	return metadata, content, nil
 }
 
 // CreateArtifact Creates a new artifact.
+// When the artifact already exists and params.IfExists is FAIL, the error is an APIError with status 409,
+// as reported by models.IsConflict.
+// The artifact type is also sent in the X-Registry-ArtifactType header, so the registry does not have to
+// detect it from the content.
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifacts/operation/createArtifact
 func (api *ArtifactsAPI) CreateArtifact(ctx context.Context, groupId string, artifact models.CreateArtifactRequest, params *models.CreateArtifactParams) (*models.ArtifactDetail, error) {
+	response, err := api.createArtifact(ctx, groupId, artifact, params)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Artifact, nil
+}
+
+// CreateArtifactWithVersion creates a new artifact like CreateArtifact, but also returns the version that was
+// created, or with params.IfExists set to FIND_OR_CREATE_VERSION the version that was found, e.g. for its
+// global ID.
+func (api *ArtifactsAPI) CreateArtifactWithVersion(ctx context.Context, groupId string, artifact models.CreateArtifactRequest, params *models.CreateArtifactParams) (*models.CreateArtifactResponse, error) {
+	return api.createArtifact(ctx, groupId, artifact, params)
+}
+
+// createArtifact issues the create request and returns the full response, including the first version.
+func (api *ArtifactsAPI) createArtifact(ctx context.Context, groupId string, artifact models.CreateArtifactRequest, params *models.CreateArtifactParams) (*models.CreateArtifactResponse, error) {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
 	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return nil, err
 	}
+	if err := validateArtifactType(api.Client, artifact.ArtifactType); err != nil {
+		return nil, err
+	}
+	if err := validateJSONSchemaContent(api.Client, artifact.ArtifactType, artifact.FirstVersion.Content.Content); err != nil {
+		return nil, err
+	}
 
 	query := ""
 	if params != nil {
 		query = "?" + params.ToQuery().Encode()
 	}
-	url := fmt.Sprintf("%s/groups/%s/artifacts%s", api.Client.BaseURL, groupId, query)
+	url := api.Client.URL("groups", groupId, "artifacts") + query
 
-	resp, err := api.executeRequest(ctx, http.MethodPost, url, artifact)
+	resp, err := api.executeRequest(ctx, http.MethodPost, url, artifact,
+		withHeader(headerIdempotencyKey, idempotencyKey(ctx)),
+		withHeader(headerArtifactType, string(artifact.ArtifactType)),
+		withPreservedIDs(ctx),
+	)
 	if err != nil {
 		return nil, err
 	}
 
 	var response models.CreateArtifactResponse
-	if err := handleResponse(resp, http.StatusOK, &response); err != nil {
+	if err := handleResponse(resp, http.StatusOK, &response, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
 
-	return &response.Artifact, nil
+	return &response, nil
+}
+
+// CreateArtifactDryRun asks the server whether the artifact could be created, without creating it, whatever
+// params.DryRun is set to. An artifact refused by the server (400 or 409), e.g. by a rule, is reported as a
+// result that is not valid, together with the reasons the server gave; other failures are returned as errors.
+func (api *ArtifactsAPI) CreateArtifactDryRun(ctx context.Context, groupId string, artifact models.CreateArtifactRequest, params *models.CreateArtifactParams) (*models.DryRunResult, error) {
+	dryRunParams := models.CreateArtifactParams{}
+	if params != nil {
+		dryRunParams = *params
+	}
+	dryRunParams.DryRun = true
+
+	response, err := api.createArtifact(ctx, groupId, artifact, &dryRunParams)
+	if err == nil {
+		return &models.DryRunResult{Valid: true, Artifact: response.Artifact, Version: response.Version}, nil
+	}
+
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) || (apiErr.Status != http.StatusBadRequest && apiErr.Status != http.StatusConflict) {
+		return nil, err
+	}
+	return &models.DryRunResult{Valid: false, Reasons: ruleViolationReasons(apiErr)}, nil
+}
+
+// CreateOrGetArtifact creates the artifact if it does not exist. If it does, the version matching the content
+// of artifact.FirstVersion is found, or added as a new version when there is none, so the caller gets the
+// resulting artifact either way. With canonical set, content is compared in its canonical form.
+func (api *ArtifactsAPI) CreateOrGetArtifact(ctx context.Context, groupId string, artifact models.CreateArtifactRequest, canonical bool) (*models.ArtifactDetail, error) {
+	params := &models.CreateArtifactParams{
+		IfExists:  models.IfExistsFindOrCreateVersion,
+		Canonical: canonical,
+	}
+	return api.CreateArtifact(ctx, groupId, artifact, params)
+}
+
+// CreateArtifactsBatch creates several artifacts in the group with at most concurrency requests in flight.
+// It returns one result per input artifact, in input order. If ctx is cancelled, artifacts that were not
+// yet created report the context error and the partial results are returned together with ctx.Err().
+func (api *ArtifactsAPI) CreateArtifactsBatch(ctx context.Context, groupId string, artifacts []models.CreateArtifactRequest, params *models.CreateArtifactParams, concurrency int) ([]models.BatchResult, error) {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
+	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
+		return nil, err
+	}
+	if concurrency <= 0 {
+		concurrency = bulkConcurrency
+	}
+
+	results := make([]models.BatchResult, len(artifacts))
+	errs := forEachBounded(ctx, len(artifacts), concurrency, func(ctx context.Context, i int) error {
+		artifact, err := api.CreateArtifact(ctx, groupId, artifacts[i], params)
+		results[i].Artifact = artifact
+		return err
+	})
+	for i, err := range errs {
+		results[i].Index = i
+		results[i].Err = err
+	}
+
+	return results, ctx.Err()
+}
+
+// ExportArtifact bundles the metadata of an artifact with the metadata, state, content, and references of
+// every version, oldest first, e.g. for offline storage or migration with ImportArtifact. Artifacts with more
+// versions than params.MaxVersions fail with ErrTooManyVersions before any content is downloaded.
+func (api *ArtifactsAPI) ExportArtifact(ctx context.Context, groupID, artifactId string, params *models.ExportArtifactParams) (*models.ArtifactExport, error) {
+	groupID = orDefaultGroup(groupID, api.DefaultGroup)
+	maxVersions := defaultExportMaxVersions
+	if params != nil {
+		if err := validateParams(params); err != nil {
+			return nil, err
+		}
+		if params.MaxVersions > 0 {
+			maxVersions = params.MaxVersions
+		}
+	}
+
+	metadata, err := NewMetadataAPI(api.Client).GetArtifactMetadata(ctx, groupID, artifactId)
+	if err != nil {
+		return nil, err
+	}
+
+	versionsAPI := NewVersionsAPI(api.Client)
+	var versions []models.ArtifactVersion
+	for {
+		page, err := versionsAPI.listArtifactVersionsPage(ctx, groupID, artifactId, &models.ListVersionsParams{
+			Offset:  len(versions),
+			Limit:   listPageSize,
+			Order:   models.OrderAsc,
+			OrderBy: models.OrderByGlobalId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, page.Versions...)
+		if len(versions) > maxVersions {
+			return nil, errors.Wrapf(ErrTooManyVersions, "%s/%s has more than %d versions", groupID, artifactId, maxVersions)
+		}
+		if len(page.Versions) == 0 || len(versions) >= page.Count {
+			break
+		}
+	}
+
+	export := &models.ArtifactExport{
+		Metadata: *metadata,
+		Versions: make([]models.ArtifactVersionExport, len(versions)),
+	}
+	errs := forEachBounded(ctx, len(versions), bulkConcurrency, func(ctx context.Context, i int) error {
+		version := versions[i].Version
+		versionMetadata, err := NewMetadataAPI(api.Client).GetArtifactVersionMetadata(ctx, groupID, artifactId, version)
+		if err != nil {
+			return err
+		}
+		content, err := versionsAPI.GetArtifactVersionContent(ctx, groupID, artifactId, version, nil)
+		if err != nil {
+			return err
+		}
+		references, err := versionsAPI.GetArtifactVersionReferences(ctx, groupID, artifactId, version, nil)
+		if err != nil {
+			return err
+		}
+		export.Versions[i] = models.ArtifactVersionExport{
+			Metadata:    *versionMetadata,
+			State:       versions[i].State,
+			Content:     content.Content,
+			ContentType: content.ContentType,
+			References:  *references,
+		}
+		return nil
+	})
+	for i, err := range errs {
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to export version %s", versions[i].Version)
+		}
+	}
+
+	return export, nil
+}
+
+// ImportArtifact recreates an artifact exported with ExportArtifact in groupID, adding its versions in order
+// with their names, labels, and references, and restoring DEPRECATED and DISABLED states. The artifact is
+// created with the first version through CreateArtifact and params.IfExists, FIND_OR_CREATE_VERSION unless
+// set; the other versions are added with CreateArtifactVersion under their exported version strings. Versions
+// the artifact already has are skipped, so an interrupted import can be retried.
+// With IfExistsFail the import fails when the artifact already exists.
+func (api *ArtifactsAPI) ImportArtifact(ctx context.Context, groupID string, exp models.ArtifactExport, params *models.CreateArtifactParams) error {
+	groupID = orDefaultGroup(groupID, api.DefaultGroup)
+	if len(exp.Versions) == 0 {
+		return errors.Wrapf(ErrInvalidArgument, "export of %s has no versions", exp.Metadata.ArtifactID)
+	}
+
+	createParams := models.CreateArtifactParams{IfExists: models.IfExistsFindOrCreateVersion}
+	if params != nil {
+		createParams = *params
+		if createParams.IfExists == "" {
+			createParams.IfExists = models.IfExistsFindOrCreateVersion
+		}
+	}
+
+	versionRequest := func(version models.ArtifactVersionExport) models.CreateVersionRequest {
+		return models.CreateVersionRequest{
+			Version: version.Metadata.Version,
+			Content: models.CreateContentRequest{
+				Content:     version.Content,
+				References:  version.References,
+				ContentType: version.ContentType,
+			},
+			Name:        version.Metadata.Name,
+			Description: version.Metadata.Description,
+			Labels:      version.Metadata.Labels,
+			IsDraft:     version.State == models.StateDraft,
+		}
+	}
+
+	artifact := models.CreateArtifactRequest{
+		ArtifactID:   exp.Metadata.ArtifactID,
+		ArtifactType: models.ArtifactType(exp.Metadata.ArtifactType),
+		Name:         exp.Metadata.Name,
+		Description:  exp.Metadata.Description,
+		Labels:       exp.Metadata.Labels,
+		FirstVersion: versionRequest(exp.Versions[0]),
+	}
+	if _, err := api.CreateArtifact(ctx, groupID, artifact, &createParams); err != nil {
+		return errors.Wrapf(err, "failed to import version %s", exp.Versions[0].Metadata.Version)
+	}
+
+	versionsAPI := NewVersionsAPI(api.Client)
+	existing := map[string]bool{}
+	err := versionsAPI.ForEachArtifactVersion(ctx, groupID, exp.Metadata.ArtifactID, nil, func(version models.ArtifactVersion) error {
+		existing[version.Version] = true
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list existing versions")
+	}
+
+	for i, version := range exp.Versions {
+		if i > 0 && !existing[version.Metadata.Version] {
+			request := versionRequest(version)
+			if _, err := versionsAPI.CreateArtifactVersion(ctx, groupID, exp.Metadata.ArtifactID, &request, false); err != nil {
+				return errors.Wrapf(err, "failed to import version %s", version.Metadata.Version)
+			}
+		}
+
+		switch version.State {
+		case models.StateDeprecated, models.StateDisabled:
+			err := versionsAPI.UpdateArtifactVersionState(ctx, groupID, exp.Metadata.ArtifactID, version.Metadata.Version, version.State, false)
+			if err != nil {
+				return errors.Wrapf(err, "failed to restore the state of version %s", version.Metadata.Version)
+			}
+		}
+	}
+
+	return nil
 }
 
 // ListArtifactRules lists all artifact rules for a given artifact.
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifact-rules/operation/createArtifactRule
 func (api *ArtifactsAPI) ListArtifactRules(ctx context.Context, groupID, artifactId string) ([]models.Rule, error) {
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s/rules", api.Client.BaseURL, groupID, artifactId)
+	groupID = orDefaultGroup(groupID, api.DefaultGroup)
+	url := api.Client.URL("groups", groupID, "artifacts", artifactId, "rules")
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var rules []models.Rule
-	if err := handleResponse(resp, http.StatusOK, &rules); err != nil {
+	if err := handleResponse(resp, http.StatusOK, &rules, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
 
 	return rules, nil
 }
 
+// ListArtifactRulesWithConfig returns the rules configured on an artifact together with their levels,
+// fetching the configuration of each rule concurrently. Rules are returned in the order ListArtifactRules lists them.
+func (api *ArtifactsAPI) ListArtifactRulesWithConfig(ctx context.Context, groupID, artifactId string) ([]models.GlobalRuleResponse, error) {
+	rules, err := api.ListArtifactRules(ctx, groupID, artifactId)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]models.GlobalRuleResponse, len(rules))
+	errs := forEachBounded(ctx, len(rules), bulkConcurrency, func(ctx context.Context, i int) error {
+		level, err := api.GetArtifactRule(ctx, groupID, artifactId, rules[i])
+		results[i] = models.GlobalRuleResponse{RuleType: rules[i], Config: level}
+		return err
+	})
+	for i, err := range errs {
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get artifact rule %s", rules[i])
+		}
+	}
+
+	return results, nil
+}
+
 // CreateArtifactRule creates a new artifact rule for a given artifact.
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifact-rules/operation/createArtifactRule
 func (api *ArtifactsAPI) CreateArtifactRule(ctx context.Context, groupID, artifactId string, rule models.Rule, level models.RuleLevel) error {
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s/rules", api.Client.BaseURL, groupID, artifactId)
+	groupID = orDefaultGroup(groupID, api.DefaultGroup)
+	if err := validateRule(rule); err != nil {
+		return err
+	}
+	url := api.Client.URL("groups", groupID, "artifacts", artifactId, "rules")
 
 	// Prepare the request body
 	body := models.CreateUpdateGlobalRuleRequest{
@@ -336,32 +853,37 @@ func (api *ArtifactsAPI) CreateArtifactRule(ctx context.Context, groupID, artifa
 		return err
 	}
 
-	return handleResponse(resp, http.StatusNoContent, nil)
+	return handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding)
 }
 
 // DeleteAllArtifactRule deletes all artifact rules for a given artifact.
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifact-rules/operation/deleteArtifactRules
 func (api *ArtifactsAPI) DeleteAllArtifactRule(ctx context.Context, groupID, artifactId string) error {
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s/rules", api.Client.BaseURL, groupID, artifactId)
+	groupID = orDefaultGroup(groupID, api.DefaultGroup)
+	url := api.Client.URL("groups", groupID, "artifacts", artifactId, "rules")
 	resp, err := api.executeRequest(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return err
 	}
 
-	return handleResponse(resp, http.StatusNoContent, nil)
+	return handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding)
 }
 
 // GetArtifactRule gets the rule level for a given artifact rule.
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifact-rules/operation/getArtifactRuleConfig
 func (api *ArtifactsAPI) GetArtifactRule(ctx context.Context, groupID, artifactId string, rule models.Rule) (models.RuleLevel, error) {
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s/rules/%s", api.Client.BaseURL, groupID, artifactId, rule)
+	groupID = orDefaultGroup(groupID, api.DefaultGroup)
+	if err := validateRule(rule); err != nil {
+		return "", err
+	}
+	url := api.Client.URL("groups", groupID, "artifacts", artifactId, "rules", string(rule))
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return "", err
 	}
 
 	var globalRule models.GlobalRuleResponse
-	if err := handleResponse(resp, http.StatusOK, &globalRule); err != nil {
+	if err := handleResponse(resp, http.StatusOK, &globalRule, api.Client.StrictDecoding); err != nil {
 		return "", err
 	}
 
@@ -371,7 +893,18 @@ func (api *ArtifactsAPI) GetArtifactRule(ctx context.Context, groupID, artifactI
 // UpdateArtifactRule updates the rule level for a given artifact rule.
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifact-rules/operation/updateArtifactRuleConfig
 func (api *ArtifactsAPI) UpdateArtifactRule(ctx context.Context, groupID, artifactId string, rule models.Rule, level models.RuleLevel) error {
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s/rules/%s", api.Client.BaseURL, groupID, artifactId, rule)
+	_, err := api.UpdateArtifactRuleWithLevel(ctx, groupID, artifactId, rule, level)
+	return err
+}
+
+// UpdateArtifactRuleWithLevel updates the rule level for a given artifact rule like UpdateArtifactRule and
+// returns the level the server applied, which may differ from the requested one.
+func (api *ArtifactsAPI) UpdateArtifactRuleWithLevel(ctx context.Context, groupID, artifactId string, rule models.Rule, level models.RuleLevel) (models.RuleLevel, error) {
+	groupID = orDefaultGroup(groupID, api.DefaultGroup)
+	if err := validateRule(rule); err != nil {
+		return "", err
+	}
+	url := api.Client.URL("groups", groupID, "artifacts", artifactId, "rules", string(rule))
 
 	// Prepare the request body
 	body := models.CreateUpdateGlobalRuleRequest{
@@ -380,31 +913,36 @@ func (api *ArtifactsAPI) UpdateArtifactRule(ctx context.Context, groupID, artifa
 	}
 	resp, err := api.executeRequest(ctx, http.MethodPut, url, body)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	var globalRule models.GlobalRuleResponse
-	if err := handleResponse(resp, http.StatusOK, &globalRule); err != nil {
-		return err
+	// A 204 response does not echo the rule, so the requested level is returned.
+	globalRule := models.GlobalRuleResponse{RuleType: rule, Config: level}
+	if err := handleUpdateResponse(resp, &globalRule, api.Client.StrictDecoding); err != nil {
+		return "", err
 	}
 
-	return nil
+	return globalRule.Config, nil
 }
 
 // DeleteArtifactRule deletes a specific artifact rule for a given artifact.
 // See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifact-rules/operation/deleteArtifactRule
 func (api *ArtifactsAPI) DeleteArtifactRule(ctx context.Context, groupID, artifactId string, rule models.Rule) error {
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s/rules/%s", api.Client.BaseURL, groupID, artifactId, rule)
+	groupID = orDefaultGroup(groupID, api.DefaultGroup)
+	if err := validateRule(rule); err != nil {
+		return err
+	}
+	url := api.Client.URL("groups", groupID, "artifacts", artifactId, "rules", string(rule))
 	resp, err := api.executeRequest(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return err
 	}
 
-	return handleResponse(resp, http.StatusNoContent, nil)
+	return handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding)
 }
 
 // executeRequest handles the creation and execution of an HTTP request.
-func (api *ArtifactsAPI) executeRequest(ctx context.Context, method, url string, body interface{}) (*http.Response, error) {
+func (api *ArtifactsAPI) executeRequest(ctx context.Context, method, url string, body interface{}, opts ...requestOption) (*http.Response, error) {
 	var reqBody []byte
 	var err error
 	contentType := "*/*"
@@ -435,10 +973,14 @@ func (api *ArtifactsAPI) executeRequest(ctx context.Context, method, url string,
 		req.Header.Set("Content-Type", contentType)
 	}
 
+	for _, opt := range opts {
+		opt(req)
+	}
+
 	// Execute the request
 	resp, err := api.Client.Do(req)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to execute HTTP request")
+		return nil, requestError(ctx, err)
 	}
 
 	return resp, nil
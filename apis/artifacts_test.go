@@ -9,9 +9,14 @@ import (
 	"github.com/subzerobo/go-apicurio-sdk/apis"
 	"github.com/subzerobo/go-apicurio-sdk/client"
 	"github.com/subzerobo/go-apicurio-sdk/models"
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -82,6 +87,150 @@ func TestSearchArtifacts(t *testing.T) {
 		assert.NotNil(t, result)
 	})
 
+	t.Run("Label Filters", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, []string{"env:prod", "teams:a,b"}, r.URL.Query()["labels"])
+
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.SearchArtifactsAPIResponse{})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		params := &models.SearchArtifactsParams{
+			LabelFilters: map[string]string{"teams": "a,b", "env": "prod"},
+		}
+		_, err := api.SearchArtifacts(context.Background(), params)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Label Filters Round Trip", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Split each filter at the first colon, as the registry does, and echo it back.
+			response := models.SearchArtifactsAPIResponse{}
+			for _, filter := range r.URL.Query()["labels"] {
+				name, value, _ := strings.Cut(filter, ":")
+				response.Artifacts = append(response.Artifacts, models.SearchedArtifact{Name: name, Description: value})
+			}
+			response.Count = len(response.Artifacts)
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(response)
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		filters := map[string]string{
+			"endpoint": "http://host:8080/orders",
+			"teams":    "a,b",
+			"selector": "app:orders,tier:web",
+			"pii":      "",
+		}
+		result, err := api.SearchArtifacts(context.Background(), &models.SearchArtifactsParams{LabelFilters: filters})
+		assert.NoError(t, err)
+
+		echoed := map[string]string{}
+		for _, artifact := range *result {
+			echoed[artifact.Name] = artifact.Description
+		}
+		assert.Equal(t, filters, echoed)
+	})
+
+	t.Run("Label Filter Key With Colon", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("no request expected for an invalid label filter")
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		params := &models.SearchArtifactsParams{LabelFilters: map[string]string{"ns:env": "prod"}}
+		_, err := api.SearchArtifacts(context.Background(), params)
+		assert.ErrorIs(t, err, apis.ErrInvalidArgument)
+	})
+
+	t.Run("Multiple Groups", func(t *testing.T) {
+		artifactsByGroup := map[string][]string{"group-a": {"artifact-1", "artifact-2"}, "group-b": {"artifact-1"}}
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			assert.Len(t, r.URL.Query()["groupId"], 1)
+			assert.Equal(t, "orders", r.URL.Query().Get("name"))
+
+			groupID := r.URL.Query().Get("groupId")
+			response := models.SearchArtifactsAPIResponse{}
+			for _, artifactID := range artifactsByGroup[groupID] {
+				response.Artifacts = append(response.Artifacts, models.SearchedArtifact{GroupId: groupID, ArtifactId: artifactID})
+			}
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(response)
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		params := &models.SearchArtifactsParams{Name: "orders", GroupIDs: []string{"group-a", "group-b", "group-a"}}
+		result, err := api.SearchArtifacts(context.Background(), params)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+		assert.Equal(t, []models.SearchedArtifact{
+			{GroupId: "group-a", ArtifactId: "artifact-1"},
+			{GroupId: "group-a", ArtifactId: "artifact-2"},
+			{GroupId: "group-b", ArtifactId: "artifact-1"},
+		}, *result)
+		assert.Equal(t, []string{"group-a", "group-b", "group-a"}, params.GroupIDs)
+	})
+
+	t.Run("Multiple Groups Error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("groupId") == "group-b" {
+				w.WriteHeader(http.StatusForbidden)
+				err := json.NewEncoder(w).Encode(models.APIError{Status: 403, Title: "Forbidden"})
+				assert.NoError(t, err)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.SearchArtifactsAPIResponse{})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		result, err := api.SearchArtifacts(context.Background(), &models.SearchArtifactsParams{GroupIDs: []string{"group-a", "group-b"}})
+		assert.True(t, models.IsForbidden(err))
+		assert.Nil(t, result)
+	})
+
+	t.Run("Static Headers", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "tenant-a", r.Header.Get("X-Tenant-ID"))
+
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.SearchArtifactsAPIResponse{})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := client.NewClient(server.URL,
+			client.WithHTTPClient(server.Client()),
+			client.WithHeaders(http.Header{"X-Tenant-ID": {"tenant-a"}}),
+		)
+		api := apis.NewArtifactsAPI(mockClient)
+
+		_, err := api.SearchArtifacts(context.Background(), &models.SearchArtifactsParams{})
+		assert.NoError(t, err)
+	})
+
 	t.Run("Server Error", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -141,14 +290,93 @@ func TestSearchArtifactsByContent(t *testing.T) {
 	})
 }
 
+func TestSearchArtifactsByContent_CanonicalizeLocally(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"a":1,"b":2}`, string(body))
+		assert.Empty(t, r.URL.Query().Get("canonicalizeLocally"))
+
+		w.WriteHeader(http.StatusOK)
+		err = json.NewEncoder(w).Encode(models.SearchArtifactsAPIResponse{})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewArtifactsAPI(mockClient)
+
+	params := &models.SearchArtifactsByContentParams{ArtifactType: string(models.Json), CanonicalizeLocally: true}
+	result, err := api.SearchArtifactsByContent(context.Background(), []byte("{\n  \"b\": 2,\n  \"a\": 1\n}"), params)
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+}
+
+func TestSearchArtifactsByContentWithRefs(t *testing.T) {
+	const content = `syntax = "proto3"; import "common.proto";`
+
+	t.Run("StructuredBody", func(t *testing.T) {
+		refs := []models.ArtifactReference{
+			{GroupID: "test-group", ArtifactID: "common", Version: "1", Name: "common.proto"},
+		}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/search/artifacts", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]interface{}{
+				"content": content,
+				"references": []interface{}{
+					map[string]interface{}{"groupId": "test-group", "artifactId": "common", "version": "1", "name": "common.proto"},
+				},
+			}, body)
+
+			w.WriteHeader(http.StatusOK)
+			err = json.NewEncoder(w).Encode(models.SearchArtifactsAPIResponse{})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		result, err := api.SearchArtifactsByContentWithRefs(context.Background(), []byte(content), refs, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("NoRefsPostsRawContent", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, content, string(body))
+
+			w.WriteHeader(http.StatusOK)
+			err = json.NewEncoder(w).Encode(models.SearchArtifactsAPIResponse{})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		result, err := api.SearchArtifactsByContentWithRefs(context.Background(), []byte(content), nil, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
 func TestListArtifactReferences(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockReferences := []models.ArtifactReference{
 			{GroupID: "group-1", ArtifactID: "artifact-1", Version: "v1"},
 		}
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			assert.Contains(t, r.URL.Path, "/references")
+			assert.Equal(t, "/ids/contentIds/123/references", r.URL.Path)
 			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Empty(t, r.URL.RawQuery)
 
 			w.WriteHeader(http.StatusOK)
 			err := json.NewEncoder(w).Encode(mockReferences)
@@ -159,12 +387,32 @@ func TestListArtifactReferences(t *testing.T) {
 		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
 		api := apis.NewArtifactsAPI(mockClient)
 
-		result, err := api.ListArtifactReferences(context.Background(), 123)
+		result, err := api.ListArtifactReferences(context.Background(), 123, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Len(t, *result, 1)
 	})
 
+	t.Run("Inbound", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/ids/contentIds/123/references", r.URL.Path)
+			assert.Equal(t, "INBOUND", r.URL.Query().Get("refType"))
+
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode([]models.ArtifactReference{})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		params := &models.ListArtifactReferencesByGlobalIDParams{RefType: models.InBound}
+		result, err := api.ListArtifactReferences(context.Background(), 123, params)
+		assert.NoError(t, err)
+		assert.Empty(t, *result)
+	})
+
 	t.Run("Not Found", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNotFound)
@@ -174,7 +422,7 @@ func TestListArtifactReferences(t *testing.T) {
 		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
 		api := apis.NewArtifactsAPI(mockClient)
 
-		result, err := api.ListArtifactReferences(context.Background(), 123)
+		result, err := api.ListArtifactReferences(context.Background(), 123, nil)
 		assert.Error(t, err)
 		assert.Nil(t, result)
 	})
@@ -288,6 +536,41 @@ func TestListArtifactsInGroup(t *testing.T) {
 		assert.Len(t, result.Artifacts, 1)
 	})
 
+	t.Run("Label Filters", func(t *testing.T) {
+		mockResponse := models.SearchArtifactsAPIResponse{
+			Artifacts: []models.SearchedArtifact{
+				{GroupId: "group-1", ArtifactId: "artifact-1"},
+			},
+			Count: 1,
+		}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/search/artifacts", r.URL.Path)
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "group-1", r.URL.Query().Get("groupId"))
+			assert.Equal(t, []string{"pii", "team:payments"}, r.URL.Query()["labels"])
+			assert.Equal(t, "10", r.URL.Query().Get("limit"))
+			assert.Equal(t, "name", r.URL.Query().Get("orderby"))
+
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(mockResponse)
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		params := &models.ListArtifactsInGroupParams{
+			Limit:        10,
+			OrderBy:      models.OrderByName,
+			LabelFilters: map[string]string{"team": "payments", "pii": ""},
+		}
+		result, err := api.ListArtifactsInGroup(context.Background(), "group-1", params)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Count)
+		assert.Equal(t, "group-1", result.Artifacts[0].GroupId)
+	})
+
 	t.Run("Not Found", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNotFound)
@@ -302,6 +585,89 @@ func TestListArtifactsInGroup(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, result)
 	})
+
+	t.Run("Invalid OrderBy", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request %s", r.URL)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		params := &models.ListArtifactsInGroupParams{OrderBy: models.OrderByGlobalId}
+		result, err := api.ListArtifactsInGroup(context.Background(), "group-1", params)
+		assert.True(t, errors.Is(err, apis.ErrInvalidArgument))
+		assert.Nil(t, result)
+	})
+}
+
+func TestArtifactsAPI_WithAPIPath(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/apis/registry/v3/groups/test-group/artifacts", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(models.ListArtifactsResponse{})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	mockClient := client.NewClient(server.URL+"/", client.WithHTTPClient(server.Client()), client.WithAPIPath(client.RegistryV3APIPath))
+	api := apis.NewArtifactsAPI(mockClient)
+
+	_, err := api.ListArtifactsInGroup(context.Background(), "test-group", nil)
+	assert.NoError(t, err)
+}
+
+func TestArtifactsAPI_URLConstruction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/groups/test-group/artifacts/my artifact.v1", r.URL.Path)
+		assert.Equal(t, "/groups/test-group/artifacts/my%20artifact.v1", r.URL.EscapedPath())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL + "/", HTTPClient: server.Client()}
+	api := apis.NewArtifactsAPI(mockClient)
+
+	err := api.DeleteArtifact(context.Background(), "test-group", "my artifact.v1")
+	assert.NoError(t, err)
+}
+
+func TestArtifactsAPI_ConnectionReuse(t *testing.T) {
+	var connections int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+		err := json.NewEncoder(w).Encode(models.APIError{Status: http.StatusNotFound, Title: "not found", Detail: strings.Repeat("x", 4096)})
+		assert.NoError(t, err)
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&connections, 1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewArtifactsAPI(mockClient)
+
+	for i := 0; i < 3; i++ {
+		_, err := api.GetArtifactContentByID(context.Background(), int64(i))
+		assert.ErrorIs(t, err, apis.ErrArtifactNotFound)
+		_, err = api.GetArtifactContentByHash(context.Background(), "hash")
+		assert.ErrorIs(t, err, apis.ErrArtifactNotFound)
+		err = api.DeleteArtifact(context.Background(), "test-group", "artifact-1")
+		assert.ErrorIs(t, err, apis.ErrMethodNotAllowed)
+		_, err = api.ListArtifactReferences(context.Background(), int64(i), nil)
+		assert.Error(t, err)
+	}
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&connections))
 }
 
 func TestGetArtifactContentByHash(t *testing.T) {
@@ -314,153 +680,927 @@ func TestGetArtifactContentByHash(t *testing.T) {
 			assert.Contains(t, r.URL.Path, "/contentHashes/hash-123")
 			assert.Equal(t, http.MethodGet, r.Method)
 
-			w.Header().Set("X-Registry-ArtifactType", "JSON")
+			w.Header().Set("X-Registry-ArtifactType", "JSON")
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(mockContent.Content))
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		result, err := api.GetArtifactContentByHash(context.Background(), "hash-123")
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, "{\"key\":\"value\"}", result.Content)
+		assert.Equal(t, models.Json, result.ArtifactType)
+	})
+
+	t.Run("Header Wins Over Body", func(t *testing.T) {
+		body := `{"artifactType": "JSON", "content": "inlined"}`
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Registry-ArtifactType", "AVRO")
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(body))
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		result, err := api.GetArtifactContentByHash(context.Background(), "hash-123")
+		assert.NoError(t, err)
+		assert.Equal(t, models.Avro, result.ArtifactType)
+		assert.Equal(t, body, result.Content)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		result, err := api.GetArtifactContentByHash(context.Background(), "hash-123")
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestContentExistsByHash(t *testing.T) {
+	newServer := func(status int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/ids/contentHashes/abc123", r.URL.Path)
+			assert.Equal(t, http.MethodHead, r.Method)
+			w.WriteHeader(status)
+		}))
+	}
+
+	t.Run("Present", func(t *testing.T) {
+		server := newServer(http.StatusOK)
+		defer server.Close()
+
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		exists, err := api.ContentExistsByHash(context.Background(), "abc123")
+		assert.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("Absent", func(t *testing.T) {
+		server := newServer(http.StatusNotFound)
+		defer server.Close()
+
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		exists, err := api.ContentExistsByHash(context.Background(), "abc123")
+		assert.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		server := newServer(http.StatusUnauthorized)
+		defer server.Close()
+
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		exists, err := api.ContentExistsByHash(context.Background(), "abc123")
+		assert.True(t, models.IsUnauthorized(err))
+		assert.False(t, exists)
+	})
+}
+
+func TestGetArtifactContentByID(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockContent := models.ArtifactContent{
+			Content:      "{\"key\":\"value\"}",
+			ArtifactType: models.Json,
+		}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Contains(t, r.URL.Path, "/contentIds/123")
+			assert.Equal(t, http.MethodGet, r.Method)
+
+			w.Header().Set("X-Registry-ArtifactType", "JSON")
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(mockContent.Content))
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		result, err := api.GetArtifactContentByID(context.Background(), 123)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, "{\"key\":\"value\"}", result.Content)
+		assert.Equal(t, models.Json, result.ArtifactType)
+	})
+
+	t.Run("Header Wins Over Body", func(t *testing.T) {
+		body := `{"artifactType": "JSON", "content": "inlined"}`
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Registry-ArtifactType", "AVRO")
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(body))
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		result, err := api.GetArtifactContentByID(context.Background(), 123)
+		assert.NoError(t, err)
+		assert.Equal(t, models.Avro, result.ArtifactType)
+		assert.Equal(t, body, result.Content)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		result, err := api.GetArtifactContentByID(context.Background(), 123)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestDeleteArtifactsInGroup(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		err := api.DeleteArtifactsInGroup(context.Background(), "test-group")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		err := api.DeleteArtifactsInGroup(context.Background(), "test-group")
+		assert.Error(t, err)
+	})
+}
+
+func TestPurgeGroup(t *testing.T) {
+	newServer := func(deleted *[]string, artifactsStatus int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			*deleted = append(*deleted, r.URL.Path)
+			if r.URL.Path == "/groups/test-group/artifacts" {
+				w.WriteHeader(artifactsStatus)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+	}
+
+	t.Run("With Group", func(t *testing.T) {
+		var deleted []string
+		server := newServer(&deleted, http.StatusNoContent)
+		defer server.Close()
+
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		assert.NoError(t, api.PurgeGroup(context.Background(), "test-group", true))
+		assert.Equal(t, []string{"/groups/test-group/artifacts", "/groups/test-group"}, deleted)
+	})
+
+	t.Run("Without Group", func(t *testing.T) {
+		var deleted []string
+		server := newServer(&deleted, http.StatusNoContent)
+		defer server.Close()
+
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		assert.NoError(t, api.PurgeGroup(context.Background(), "test-group", false))
+		assert.Equal(t, []string{"/groups/test-group/artifacts"}, deleted)
+	})
+
+	t.Run("Artifacts Not Deleted", func(t *testing.T) {
+		var deleted []string
+		server := newServer(&deleted, http.StatusForbidden)
+		defer server.Close()
+
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		assert.Error(t, api.PurgeGroup(context.Background(), "test-group", true))
+		assert.Equal(t, []string{"/groups/test-group/artifacts"}, deleted)
+	})
+}
+
+func TestDeleteArtifact(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		err := api.DeleteArtifact(context.Background(), "test-group", "artifact-1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Not Allowed", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		err := api.DeleteArtifact(context.Background(), "test-group", "artifact-1")
+		assert.Error(t, err)
+		assert.Equal(t, apis.ErrMethodNotAllowed, err)
+	})
+}
+
+func TestArtifactExists(t *testing.T) {
+	newServer := func(status int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/groups/test-group/artifacts/artifact-1", r.URL.Path)
+			assert.Equal(t, http.MethodGet, r.Method)
+
+			w.WriteHeader(status)
+			if status == http.StatusOK {
+				err := json.NewEncoder(w).Encode(models.ArtifactMetadata{})
+				assert.NoError(t, err)
+				return
+			}
+			err := json.NewEncoder(w).Encode(models.APIError{Status: status, Title: http.StatusText(status)})
+			assert.NoError(t, err)
+		}))
+	}
+
+	t.Run("Exists", func(t *testing.T) {
+		server := newServer(http.StatusOK)
+		defer server.Close()
+
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		exists, err := api.ArtifactExists(context.Background(), "test-group", "artifact-1")
+		assert.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		server := newServer(http.StatusNotFound)
+		defer server.Close()
+
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		exists, err := api.ArtifactExists(context.Background(), "test-group", "artifact-1")
+		assert.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("Server Error", func(t *testing.T) {
+		server := newServer(http.StatusInternalServerError)
+		defer server.Close()
+
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		exists, err := api.ArtifactExists(context.Background(), "test-group", "artifact-1")
+		assert.True(t, models.IsServerError(err))
+		assert.False(t, exists)
+	})
+}
+
+func TestGetArtifact(t *testing.T) {
+	newServer := func(t *testing.T, metadataStatus, contentStatus int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			switch r.URL.Path {
+			case "/groups/test-group/artifacts/artifact-1":
+				w.WriteHeader(metadataStatus)
+				if metadataStatus == http.StatusOK {
+					err := json.NewEncoder(w).Encode(models.ArtifactMetadata{BaseMetadata: models.BaseMetadata{
+						GroupID: "test-group", ArtifactID: "artifact-1", ArtifactType: string(models.Json),
+					}})
+					assert.NoError(t, err)
+				}
+			case "/groups/test-group/artifacts/artifact-1/versions/branch=latest/content":
+				w.WriteHeader(contentStatus)
+				_, _ = w.Write([]byte(`{"type":"object"}`))
+			default:
+				t.Errorf("unexpected request %s", r.URL)
+			}
+		}))
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		server := newServer(t, http.StatusOK, http.StatusOK)
+		defer server.Close()
+
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		metadata, content, err := api.GetArtifact(context.Background(), "test-group", "artifact-1")
+		assert.NoError(t, err)
+		assert.Equal(t, "artifact-1", metadata.ArtifactID)
+		assert.Equal(t, `{"type":"object"}`, content.Content)
+		assert.Equal(t, models.Json, content.ArtifactType)
+	})
+
+	t.Run("Metadata Error", func(t *testing.T) {
+		server := newServer(t, http.StatusNotFound, http.StatusOK)
+		defer server.Close()
+
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		metadata, content, err := api.GetArtifact(context.Background(), "test-group", "artifact-1")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get artifact metadata")
+		assert.Nil(t, metadata)
+		assert.Nil(t, content)
+	})
+
+	t.Run("Content Error", func(t *testing.T) {
+		server := newServer(t, http.StatusOK, http.StatusInternalServerError)
+		defer server.Close()
+
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		metadata, content, err := api.GetArtifact(context.Background(), "test-group", "artifact-1")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get latest version content")
+		assert.Nil(t, metadata)
+		assert.Nil(t, content)
+	})
+}
+
+func TestCreateArtifact(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockResponse := models.CreateArtifactResponse{
+			Artifact: models.ArtifactDetail{
+				GroupID:     "test-group",
+				ArtifactID:  "artifact-1",
+				Name:        "New Artifact",
+				Description: "Test Description",
+			},
+		}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Contains(t, r.URL.Path, "/artifacts")
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, string(models.Json), r.Header.Get("X-Registry-ArtifactType"))
+
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(mockResponse)
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		artifact := models.CreateArtifactRequest{
+			ArtifactType: models.Json,
+			FirstVersion: models.CreateVersionRequest{
+				Version: "1.0.0",
+				Content: models.CreateContentRequest{
+					Content: "{\"key\":\"value\"}",
+				},
+			},
+		}
+		params := &models.CreateArtifactParams{
+			IfExists: models.IfExistsCreate,
+		}
+		result, err := api.CreateArtifact(context.Background(), "test-group", artifact, params)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, "artifact-1", result.ArtifactID)
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusConflict)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		artifact := models.CreateArtifactRequest{
+			ArtifactType: models.Json,
+			FirstVersion: models.CreateVersionRequest{
+				Version: "1.0.0",
+				Content: models.CreateContentRequest{
+					Content: "{\"key\":\"value\"}",
+				},
+			},
+		}
+
+		params := &models.CreateArtifactParams{}
+		result, err := api.CreateArtifact(context.Background(), "test-group", artifact, params)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.True(t, models.IsConflict(err))
+
+		var apiErr *models.APIError
+		ok := errors.As(err, &apiErr)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusConflict, apiErr.Status)
+		assert.Equal(t, "Conflict", apiErr.Title)
+	})
+
+	t.Run("Idempotency Key", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "create-artifact-1", r.Header.Get("X-Idempotency-Key"))
+
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.CreateArtifactResponse{Artifact: models.ArtifactDetail{ArtifactID: "artifact-1"}})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		artifact := models.CreateArtifactRequest{
+			ArtifactType: models.Json,
+			FirstVersion: models.CreateVersionRequest{
+				Content: models.CreateContentRequest{Content: "{\"key\":\"value\"}"},
+			},
+		}
+		ctx := apis.WithIdempotencyKey(context.Background(), "create-artifact-1")
+		result, err := api.CreateArtifact(ctx, "test-group", artifact, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "artifact-1", result.ArtifactID)
+	})
+
+	t.Run("Preserved IDs", func(t *testing.T) {
+		var headers []http.Header
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			headers = append(headers, r.Header.Clone())
+
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.CreateArtifactResponse{Artifact: models.ArtifactDetail{ArtifactID: "artifact-1"}})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		artifact := models.CreateArtifactRequest{
+			ArtifactType: models.Json,
+			FirstVersion: models.CreateVersionRequest{
+				Content: models.CreateContentRequest{Content: "{\"key\":\"value\"}"},
+			},
+		}
+		_, err := api.CreateArtifact(apis.WithPreservedIDs(context.Background(), 42, 7), "test-group", artifact, nil)
+		assert.NoError(t, err)
+		_, err = api.CreateArtifact(context.Background(), "test-group", artifact, nil)
+		assert.NoError(t, err)
+
+		assert.Len(t, headers, 2)
+		assert.Equal(t, "42", headers[0].Get("X-Registry-Preserve-GlobalId"))
+		assert.Equal(t, "7", headers[0].Get("X-Registry-Preserve-ContentId"))
+		assert.Empty(t, headers[1].Values("X-Registry-Preserve-GlobalId"))
+		assert.Empty(t, headers[1].Values("X-Registry-Preserve-ContentId"))
+	})
+}
+
+func TestCreateArtifactWithVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/groups/test-group/artifacts", r.URL.Path)
+		assert.Equal(t, "FIND_OR_CREATE_VERSION", r.URL.Query().Get("ifExists"))
+
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(models.CreateArtifactResponse{
+			Artifact: models.ArtifactDetail{GroupID: "test-group", ArtifactID: "artifact-1"},
+			Version:  models.ArtifactVersionDetailed{ArtifactVersion: models.ArtifactVersion{Version: "3", GlobalID: 42}},
+		})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewArtifactsAPI(mockClient)
+
+	artifact := models.CreateArtifactRequest{
+		ArtifactID:   "artifact-1",
+		ArtifactType: models.Json,
+		FirstVersion: models.CreateVersionRequest{Content: models.CreateContentRequest{Content: `{"key":"value"}`}},
+	}
+	result, err := api.CreateArtifactWithVersion(context.Background(), "test-group", artifact, &models.CreateArtifactParams{IfExists: models.IfExistsFindOrCreateVersion})
+	assert.NoError(t, err)
+	assert.Equal(t, "artifact-1", result.Artifact.ArtifactID)
+	assert.Equal(t, "3", result.Version.Version)
+	assert.Equal(t, int64(42), result.Version.GlobalID)
+}
+
+func TestCreateArtifactDryRun(t *testing.T) {
+	artifact := models.CreateArtifactRequest{
+		ArtifactType: models.Json,
+		FirstVersion: models.CreateVersionRequest{
+			Version: "1.0.0",
+			Content: models.CreateContentRequest{
+				Content: "{\"key\":\"value\"}",
+			},
+		},
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		mockResponse := models.CreateArtifactResponse{
+			Artifact: models.ArtifactDetail{GroupID: "test-group", ArtifactID: "artifact-1"},
+			Version:  models.ArtifactVersionDetailed{ArtifactVersion: models.ArtifactVersion{Version: "1.0.0"}},
+		}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "true", r.URL.Query().Get("dryRun"))
+			assert.Equal(t, string(models.IfExistsCreate), r.URL.Query().Get("ifExists"))
+
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(mockResponse)
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		params := &models.CreateArtifactParams{IfExists: models.IfExistsCreate}
+		result, err := api.CreateArtifactDryRun(context.Background(), "test-group", artifact, params)
+		assert.NoError(t, err)
+		assert.True(t, result.Valid)
+		assert.Empty(t, result.Reasons)
+		assert.Equal(t, "artifact-1", result.Artifact.ArtifactID)
+		assert.Equal(t, "1.0.0", result.Version.Version)
+		assert.False(t, params.DryRun)
+	})
+
+	t.Run("Rule Violation", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "true", r.URL.Query().Get("dryRun"))
+			w.WriteHeader(http.StatusConflict)
+			err := json.NewEncoder(w).Encode(models.APIError{
+				Status: http.StatusConflict,
+				Title:  "Rule violation",
+				Causes: []models.RuleViolationCause{{Description: "invalid syntax", Context: "/"}},
+			})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		result, err := api.CreateArtifactDryRun(context.Background(), "test-group", artifact, nil)
+		assert.NoError(t, err)
+		assert.False(t, result.Valid)
+		assert.Equal(t, []string{"invalid syntax (at /)"}, result.Reasons)
+	})
+
+	t.Run("Server Error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+
+		result, err := api.CreateArtifactDryRun(context.Background(), "test-group", artifact, nil)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestCreateArtifact_JSONSchemaValidation(t *testing.T) {
+	newRequest := func(content string) models.CreateArtifactRequest {
+		return models.CreateArtifactRequest{
+			ArtifactType: models.Json,
+			FirstVersion: models.CreateVersionRequest{
+				Content: models.CreateContentRequest{Content: content, ContentType: "application/json"},
+			},
+		}
+	}
+
+	t.Run("InvalidSchemaIsRejectedLocally", func(t *testing.T) {
+		called := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		mockClient := client.NewClient(server.URL, client.WithHTTPClient(server.Client()), client.WithJSONSchemaValidation())
+		api := apis.NewArtifactsAPI(mockClient)
+
+		result, err := api.CreateArtifact(context.Background(), "test-group", newRequest(`{"type": "strnig"}`), nil)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, models.ErrInvalidJSONSchema))
+		assert.False(t, called)
+	})
+
+	t.Run("ValidSchemaIsSent", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusOK)
-			_, err := w.Write([]byte(mockContent.Content))
+			err := json.NewEncoder(w).Encode(models.CreateArtifactResponse{Artifact: models.ArtifactDetail{ArtifactID: "artifact-1"}})
 			assert.NoError(t, err)
 		}))
 		defer server.Close()
 
-		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		mockClient := client.NewClient(server.URL, client.WithHTTPClient(server.Client()), client.WithJSONSchemaValidation())
 		api := apis.NewArtifactsAPI(mockClient)
 
-		result, err := api.GetArtifactContentByHash(context.Background(), "hash-123")
+		result, err := api.CreateArtifact(context.Background(), "test-group", newRequest(`{"type": "object"}`), nil)
 		assert.NoError(t, err)
-		assert.NotNil(t, result)
-		assert.Equal(t, "{\"key\":\"value\"}", result.Content)
-		assert.Equal(t, models.Json, result.ArtifactType)
+		assert.Equal(t, "artifact-1", result.ArtifactID)
 	})
 
-	t.Run("Not Found", func(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(http.StatusNotFound)
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.CreateArtifactResponse{Artifact: models.ArtifactDetail{ArtifactID: "artifact-1"}})
+			assert.NoError(t, err)
 		}))
 		defer server.Close()
 
 		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
 		api := apis.NewArtifactsAPI(mockClient)
 
-		result, err := api.GetArtifactContentByHash(context.Background(), "hash-123")
-		assert.Error(t, err)
-		assert.Nil(t, result)
+		result, err := api.CreateArtifact(context.Background(), "test-group", newRequest(`{"type": "strnig"}`), nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
 	})
 }
 
-func TestGetArtifactContentByID(t *testing.T) {
-	t.Run("Success", func(t *testing.T) {
-		mockContent := models.ArtifactContent{
-			Content:      "{\"key\":\"value\"}",
-			ArtifactType: models.Json,
+func TestCreateArtifact_ArtifactTypeValidation(t *testing.T) {
+	newRequest := func(artifactType models.ArtifactType) models.CreateArtifactRequest {
+		return models.CreateArtifactRequest{
+			ArtifactID:   "artifact-1",
+			ArtifactType: artifactType,
+			FirstVersion: models.CreateVersionRequest{
+				Content: models.CreateContentRequest{Content: `{"type": "string"}`, ContentType: "application/json"},
+			},
 		}
-		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			assert.Contains(t, r.URL.Path, "/contentIds/123")
-			assert.Equal(t, http.MethodGet, r.Method)
+	}
 
-			w.Header().Set("X-Registry-ArtifactType", "JSON")
-			w.WriteHeader(http.StatusOK)
-			_, err := w.Write([]byte(mockContent.Content))
-			assert.NoError(t, err)
-		}))
-		defer server.Close()
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(models.CreateArtifactResponse{Artifact: models.ArtifactDetail{ArtifactID: "artifact-1"}})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
 
-		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	t.Run("KnownType", func(t *testing.T) {
+		called = false
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+
+		result, err := api.CreateArtifact(context.Background(), "test-group", newRequest(models.Avro), nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.True(t, called)
+	})
+
+	t.Run("UnknownTypeIsRejectedLocally", func(t *testing.T) {
+		called = false
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+
+		for _, artifactType := range []models.ArtifactType{"", "AVOR"} {
+			result, err := api.CreateArtifact(context.Background(), "test-group", newRequest(artifactType), nil)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+			assert.True(t, errors.Is(err, models.ErrUnknownArtifactType))
+		}
+		assert.False(t, called)
+	})
+
+	t.Run("UnknownTypeAllowed", func(t *testing.T) {
+		called = false
+		mockClient := client.NewClient(server.URL, client.WithHTTPClient(server.Client()), client.WithAllowUnknownArtifactTypes())
 		api := apis.NewArtifactsAPI(mockClient)
 
-		result, err := api.GetArtifactContentByID(context.Background(), 123)
+		result, err := api.CreateArtifact(context.Background(), "test-group", newRequest("CUSTOM"), nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
-		assert.Equal(t, "{\"key\":\"value\"}", result.Content)
-		assert.Equal(t, models.Json, result.ArtifactType)
+		assert.True(t, called)
 	})
+}
+
+func TestCreateArtifact_WithReferences(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		content := body["firstVersion"].(map[string]interface{})["content"].(map[string]interface{})
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"groupId": "com.example", "artifactId": "address", "version": "1", "name": "com.example.Address"},
+		}, content["references"])
+
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(models.CreateArtifactResponse{Artifact: models.ArtifactDetail{ArtifactID: "user"}})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewArtifactsAPI(mockClient)
+
+	refs := []models.ArtifactReference{{GroupID: "com.example", ArtifactID: "address", Version: "1", Name: "com.example.Address"}}
+	artifact := models.CreateArtifactRequest{
+		ArtifactID:   "user",
+		ArtifactType: models.Avro,
+		FirstVersion: models.CreateVersionRequest{
+			Content: models.ContentWithReferences(`{"type":"record","name":"User","fields":[{"name":"address","type":"com.example.Address"}]}`, refs),
+		},
+	}
+	result, err := api.CreateArtifact(context.Background(), "test-group", artifact, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "user", result.ArtifactID)
+}
+
+func TestArtifactsAPI_ResolveReferencesClosure(t *testing.T) {
+	t.Run("Graph With Cycle", func(t *testing.T) {
+		// root (globalId 1) -> a -> b -> a, and root -> c
+		references := map[string][]models.ArtifactReference{
+			"/ids/globalIds/1/references": {
+				{GroupID: "g", ArtifactID: "a", Version: "1"},
+				{GroupID: "g", ArtifactID: "c", Version: "1"},
+			},
+			"/groups/g/artifacts/a/versions/1/references": {{GroupID: "g", ArtifactID: "b", Version: "2"}},
+			"/groups/g/artifacts/b/versions/2/references": {{GroupID: "g", ArtifactID: "a", Version: "1"}},
+			"/groups/g/artifacts/c/versions/1/references": {},
+		}
+		contentRequests := map[string]int{}
 
-	t.Run("Not Found", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(http.StatusNotFound)
+			if strings.HasSuffix(r.URL.Path, "/content") {
+				contentRequests[r.URL.Path]++
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte("content of " + r.URL.Path))
+				return
+			}
+			refs, ok := references[r.URL.Path]
+			if !assert.True(t, ok, r.URL.Path) {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			assert.Equal(t, "OUTBOUND", r.URL.Query().Get("refType"))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(refs)
+			assert.NoError(t, err)
 		}))
 		defer server.Close()
 
 		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
 		api := apis.NewArtifactsAPI(mockClient)
 
-		result, err := api.GetArtifactContentByID(context.Background(), 123)
-		assert.Error(t, err)
-		assert.Nil(t, result)
+		closure, err := api.ResolveReferencesClosure(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Len(t, closure, 3)
+		assert.Equal(t, "content of /groups/g/artifacts/a/versions/1/content", closure["g:a:1"].Content)
+		assert.Equal(t, "content of /groups/g/artifacts/b/versions/2/content", closure["g:b:2"].Content)
+		assert.Equal(t, "content of /groups/g/artifacts/c/versions/1/content", closure["g:c:1"].Content)
+		for path, count := range contentRequests {
+			assert.Equal(t, 1, count, path)
+		}
 	})
-}
 
-func TestDeleteArtifactsInGroup(t *testing.T) {
-	t.Run("Success", func(t *testing.T) {
+	t.Run("Reference Without Group", func(t *testing.T) {
+		references := map[string][]models.ArtifactReference{
+			"/ids/globalIds/1/references":                       {{ArtifactID: "a", Version: "1"}},
+			"/groups/default/artifacts/a/versions/1/references": {{GroupID: "default", ArtifactID: "a", Version: "1"}},
+		}
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(http.StatusNoContent)
+			if strings.HasSuffix(r.URL.Path, "/content") {
+				assert.Equal(t, "/groups/default/artifacts/a/versions/1/content", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte("content of a"))
+				return
+			}
+			refs, ok := references[r.URL.Path]
+			if !assert.True(t, ok, r.URL.Path) {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(refs)
+			assert.NoError(t, err)
 		}))
 		defer server.Close()
 
+		// The client's default group does not apply to references, which the registry resolves in "default".
 		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
 		api := apis.NewArtifactsAPI(mockClient)
+		api.DefaultGroup = "orders"
 
-		err := api.DeleteArtifactsInGroup(context.Background(), "test-group")
+		closure, err := api.ResolveReferencesClosure(context.Background(), 1)
 		assert.NoError(t, err)
+		assert.Len(t, closure, 1)
+		assert.Equal(t, "content of a", closure["default:a:1"].Content)
 	})
 
-	t.Run("Forbidden", func(t *testing.T) {
+	t.Run("Missing Reference", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(http.StatusForbidden)
+			if r.URL.Path == "/ids/globalIds/1/references" {
+				w.WriteHeader(http.StatusOK)
+				err := json.NewEncoder(w).Encode([]models.ArtifactReference{{GroupID: "g", ArtifactID: "gone", Version: "1"}})
+				assert.NoError(t, err)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			err := json.NewEncoder(w).Encode(models.APIError{Status: 404, Title: "Not found"})
+			assert.NoError(t, err)
 		}))
 		defer server.Close()
 
 		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
 		api := apis.NewArtifactsAPI(mockClient)
 
-		err := api.DeleteArtifactsInGroup(context.Background(), "test-group")
+		closure, err := api.ResolveReferencesClosure(context.Background(), 1)
 		assert.Error(t, err)
+		assert.Nil(t, closure)
+		assert.Contains(t, err.Error(), "g:gone:1")
 	})
 }
 
-func TestDeleteArtifact(t *testing.T) {
-	t.Run("Success", func(t *testing.T) {
+func TestCreateOrGetArtifact(t *testing.T) {
+	artifact := models.CreateArtifactRequest{
+		ArtifactID:   "artifact-1",
+		ArtifactType: models.Json,
+		FirstVersion: models.CreateVersionRequest{
+			Content: models.CreateContentRequest{Content: `{"type": "object"}`, ContentType: "application/json"},
+		},
+	}
+
+	t.Run("Created", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(http.StatusNoContent)
+			assert.Equal(t, "/groups/test-group/artifacts", r.URL.Path)
+			assert.Equal(t, "FIND_OR_CREATE_VERSION", r.URL.Query().Get("ifExists"))
+			assert.Equal(t, "true", r.URL.Query().Get("canonical"))
+
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.CreateArtifactResponse{
+				Artifact: models.ArtifactDetail{GroupID: "test-group", ArtifactID: "artifact-1", CreatedOn: "2024-12-10T08:56:40Z"},
+			})
+			assert.NoError(t, err)
 		}))
 		defer server.Close()
 
-		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
-		api := apis.NewArtifactsAPI(mockClient)
-
-		err := api.DeleteArtifact(context.Background(), "test-group", "artifact-1")
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		result, err := api.CreateOrGetArtifact(context.Background(), "test-group", artifact, true)
 		assert.NoError(t, err)
+		assert.Equal(t, "artifact-1", result.ArtifactID)
 	})
 
-	t.Run("Not Allowed", func(t *testing.T) {
+	t.Run("FoundExisting", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(http.StatusMethodNotAllowed)
+			assert.Equal(t, "FIND_OR_CREATE_VERSION", r.URL.Query().Get("ifExists"))
+			assert.Empty(t, r.URL.Query().Get("canonical"))
+
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.CreateArtifactResponse{
+				Artifact: models.ArtifactDetail{GroupID: "test-group", ArtifactID: "artifact-1", CreatedOn: "2023-01-01T00:00:00Z"},
+			})
+			assert.NoError(t, err)
 		}))
 		defer server.Close()
 
-		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
-		api := apis.NewArtifactsAPI(mockClient)
-
-		err := api.DeleteArtifact(context.Background(), "test-group", "artifact-1")
-		assert.Error(t, err)
-		assert.Equal(t, apis.ErrMethodNotAllowed, err)
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		result, err := api.CreateOrGetArtifact(context.Background(), "test-group", artifact, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "artifact-1", result.ArtifactID)
+		assert.Equal(t, "2023-01-01T00:00:00Z", result.CreatedOn)
 	})
 }
 
-func TestCreateArtifact(t *testing.T) {
-	t.Run("Success", func(t *testing.T) {
-		mockResponse := models.CreateArtifactResponse{
-			Artifact: models.ArtifactDetail{
-				GroupID:     "test-group",
-				ArtifactID:  "artifact-1",
-				Name:        "New Artifact",
-				Description: "Test Description",
-			},
-		}
+func TestCreateArtifactsBatch(t *testing.T) {
+	t.Run("MixedResults", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			assert.Contains(t, r.URL.Path, "/artifacts")
-			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/groups/test-group/artifacts", r.URL.Path)
+			assert.Equal(t, "FAIL", r.URL.Query().Get("ifExists"))
+
+			var request models.CreateArtifactRequest
+			err := json.NewDecoder(r.Body).Decode(&request)
+			assert.NoError(t, err)
 
+			if strings.HasPrefix(request.ArtifactID, "existing") {
+				w.WriteHeader(http.StatusConflict)
+				err = json.NewEncoder(w).Encode(models.APIError{Status: http.StatusConflict, Title: "Artifact already exists"})
+				assert.NoError(t, err)
+				return
+			}
 			w.WriteHeader(http.StatusOK)
-			err := json.NewEncoder(w).Encode(mockResponse)
+			err = json.NewEncoder(w).Encode(models.CreateArtifactResponse{
+				Artifact: models.ArtifactDetail{GroupID: "test-group", ArtifactID: request.ArtifactID},
+			})
 			assert.NoError(t, err)
 		}))
 		defer server.Close()
@@ -468,50 +1608,262 @@ func TestCreateArtifact(t *testing.T) {
 		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
 		api := apis.NewArtifactsAPI(mockClient)
 
-		artifact := models.CreateArtifactRequest{
-			ArtifactType: models.Json,
-			FirstVersion: models.CreateVersionRequest{
-				Version: "1.0.0",
-				Content: models.CreateContentRequest{
-					Content: "{\"key\":\"value\"}",
-				},
-			},
-		}
-		params := &models.CreateArtifactParams{
-			IfExists: models.IfExistsCreate,
+		artifacts := []models.CreateArtifactRequest{
+			{ArtifactID: "new-1", ArtifactType: models.Json},
+			{ArtifactID: "existing-1", ArtifactType: models.Json},
+			{ArtifactID: "new-2", ArtifactType: models.Json},
+			{ArtifactID: "existing-2", ArtifactType: models.Json},
 		}
-		result, err := api.CreateArtifact(context.Background(), "test-group", artifact, params)
+		params := &models.CreateArtifactParams{IfExists: models.IfExistsFail}
+		results, err := api.CreateArtifactsBatch(context.Background(), "test-group", artifacts, params, 2)
 		assert.NoError(t, err)
-		assert.NotNil(t, result)
-		assert.Equal(t, "artifact-1", result.ArtifactID)
+		assert.Len(t, results, len(artifacts))
+
+		for i, result := range results {
+			assert.Equal(t, i, result.Index)
+			if strings.HasPrefix(artifacts[i].ArtifactID, "existing") {
+				assert.Nil(t, result.Artifact)
+				var apiErr *models.APIError
+				assert.True(t, errors.As(result.Err, &apiErr))
+				assert.Equal(t, http.StatusConflict, apiErr.Status)
+			} else {
+				assert.NoError(t, result.Err)
+				assert.Equal(t, artifacts[i].ArtifactID, result.Artifact.ArtifactID)
+			}
+		}
 	})
 
-	t.Run("Conflict", func(t *testing.T) {
+	t.Run("CancelledContext", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(http.StatusConflict)
+			t.Error("no request expected")
 		}))
 		defer server.Close()
 
 		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
 		api := apis.NewArtifactsAPI(mockClient)
 
-		artifact := models.CreateArtifactRequest{
-			ArtifactType: models.Json,
-			FirstVersion: models.CreateVersionRequest{
-				Version: "1.0.0",
-				Content: models.CreateContentRequest{
-					Content: "{\"key\":\"value\"}",
-				},
-			},
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		artifacts := []models.CreateArtifactRequest{{ArtifactID: "a"}, {ArtifactID: "b"}}
+		results, err := api.CreateArtifactsBatch(ctx, "test-group", artifacts, nil, 0)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Len(t, results, 2)
+		for i, result := range results {
+			assert.Equal(t, i, result.Index)
+			assert.ErrorIs(t, result.Err, context.Canceled)
 		}
+	})
+}
 
-		params := &models.CreateArtifactParams{}
-		result, err := api.CreateArtifact(context.Background(), "test-group", artifact, params)
-		assert.Error(t, err)
-		assert.Nil(t, result)
+func TestArtifactsAPI_UnknownRule(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewArtifactsAPI(mockClient)
+	ctx := context.Background()
+	rule := models.Rule("SECURITY")
+
+	err := api.CreateArtifactRule(ctx, "test-group", "artifact-1", rule, models.ValidityLevelFull)
+	assert.ErrorIs(t, err, apis.ErrInvalidArgument)
+	_, err = api.GetArtifactRule(ctx, "test-group", "artifact-1", rule)
+	assert.ErrorIs(t, err, apis.ErrInvalidArgument)
+	err = api.UpdateArtifactRule(ctx, "test-group", "artifact-1", rule, models.ValidityLevelFull)
+	assert.ErrorIs(t, err, apis.ErrInvalidArgument)
+	err = api.DeleteArtifactRule(ctx, "test-group", "artifact-1", rule)
+	assert.ErrorIs(t, err, apis.ErrInvalidArgument)
+}
+
+// exportFixture serves a two-version artifact "g/orders" for the export tests.
+func exportFixture(t *testing.T) http.Handler {
+	versionMetadata := map[string]models.ArtifactVersionMetadata{
+		"1": {BaseMetadata: models.BaseMetadata{GroupID: "g", ArtifactID: "orders", Name: "Orders v1", Labels: map[string]string{"stage": "old"}}, Version: "1", GlobalID: 10},
+		"2": {BaseMetadata: models.BaseMetadata{GroupID: "g", ArtifactID: "orders", Name: "Orders v2", Labels: map[string]string{"stage": "new"}}, Version: "2", GlobalID: 11},
+	}
+	references := map[string][]models.ArtifactReference{
+		"1": {},
+		"2": {{GroupID: "g", ArtifactID: "customer", Version: "1", Name: "customer.json"}},
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		path := strings.TrimPrefix(r.URL.Path, "/groups/g/artifacts/orders")
+		var body interface{}
+		switch {
+		case path == "":
+			body = models.ArtifactMetadata{BaseMetadata: models.BaseMetadata{GroupID: "g", ArtifactID: "orders", ArtifactType: string(models.Json), Labels: map[string]string{"team": "a"}}}
+		case path == "/versions":
+			assert.Equal(t, "globalId", r.URL.Query().Get("orderby"))
+			assert.Equal(t, "asc", r.URL.Query().Get("order"))
+			body = models.ArtifactVersionListResponse{Count: 2, Versions: []models.ArtifactVersion{
+				{Version: "1", GlobalID: 10, State: models.StateDeprecated},
+				{Version: "2", GlobalID: 11, State: models.StateEnabled},
+			}}
+		case strings.HasSuffix(path, "/content"):
+			version := strings.TrimSuffix(strings.TrimPrefix(path, "/versions/"), "/content")
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"version": ` + version + `}`))
+			return
+		case strings.HasSuffix(path, "/references"):
+			body = references[strings.TrimSuffix(strings.TrimPrefix(path, "/versions/"), "/references")]
+		default:
+			body = versionMetadata[strings.TrimPrefix(path, "/versions/")]
+		}
+		err := json.NewEncoder(w).Encode(body)
+		assert.NoError(t, err)
+	})
+}
+
+func TestArtifactsAPI_ExportArtifact(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		server := httptest.NewServer(exportFixture(t))
+		defer server.Close()
+
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		export, err := api.ExportArtifact(context.Background(), "g", "orders", nil)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "orders", export.Metadata.ArtifactID)
+		assert.Equal(t, map[string]string{"team": "a"}, export.Metadata.Labels)
+		if assert.Len(t, export.Versions, 2) {
+			first, second := export.Versions[0], export.Versions[1]
+			assert.Equal(t, "1", first.Metadata.Version)
+			assert.Equal(t, map[string]string{"stage": "old"}, first.Metadata.Labels)
+			assert.Equal(t, models.StateDeprecated, first.State)
+			assert.Equal(t, `{"version": 1}`, first.Content)
+			assert.Equal(t, "application/json", first.ContentType)
+			assert.Empty(t, first.References)
+
+			assert.Equal(t, "Orders v2", second.Metadata.Name)
+			assert.Equal(t, `{"version": 2}`, second.Content)
+			assert.Equal(t, []models.ArtifactReference{{GroupID: "g", ArtifactID: "customer", Version: "1", Name: "customer.json"}}, second.References)
+		}
+	})
+
+	t.Run("Too Many Versions", func(t *testing.T) {
+		server := httptest.NewServer(exportFixture(t))
+		defer server.Close()
+
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		export, err := api.ExportArtifact(context.Background(), "g", "orders", &models.ExportArtifactParams{MaxVersions: 1})
+		assert.True(t, errors.Is(err, apis.ErrTooManyVersions))
+		assert.Nil(t, export)
+	})
+
+	t.Run("Server Caps Page Size", func(t *testing.T) {
+		fixture := exportFixture(t)
+		all := []models.ArtifactVersion{
+			{Version: "1", GlobalID: 10, State: models.StateDeprecated},
+			{Version: "2", GlobalID: 11, State: models.StateEnabled},
+		}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/groups/g/artifacts/orders/versions" {
+				fixture.ServeHTTP(w, r)
+				return
+			}
+			// Return a single version per page whatever limit was asked for.
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			page := models.ArtifactVersionListResponse{Count: len(all)}
+			if offset < len(all) {
+				page.Versions = all[offset : offset+1]
+			}
+			err := json.NewEncoder(w).Encode(page)
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		api := apis.NewArtifactsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		export, err := api.ExportArtifact(context.Background(), "g", "orders", nil)
+		assert.NoError(t, err)
+		if assert.Len(t, export.Versions, 2) {
+			assert.Equal(t, "1", export.Versions[0].Metadata.Version)
+			assert.Equal(t, "2", export.Versions[1].Metadata.Version)
+		}
 	})
 }
 
+func TestArtifactsAPI_ImportArtifact(t *testing.T) {
+	source := httptest.NewServer(exportFixture(t))
+	defer source.Close()
+
+	export, err := apis.NewArtifactsAPI(&client.Client{BaseURL: source.URL, HTTPClient: source.Client()}).
+		ExportArtifact(context.Background(), "g", "orders", nil)
+	assert.NoError(t, err)
+	// A version may repeat the content of an earlier one; it must still be imported.
+	export.Versions[1].Content = export.Versions[0].Content
+
+	var (
+		artifact *models.CreateArtifactRequest
+		versions []models.CreateVersionRequest
+	)
+	states := map[string]models.State{}
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/groups/target/artifacts":
+			assert.Equal(t, "FIND_OR_CREATE_VERSION", r.URL.Query().Get("ifExists"))
+			var request models.CreateArtifactRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			if artifact == nil {
+				artifact = &request
+				versions = append(versions, request.FirstVersion)
+			}
+			err := json.NewEncoder(w).Encode(models.CreateArtifactResponse{Artifact: models.ArtifactDetail{ArtifactID: request.ArtifactID}})
+			assert.NoError(t, err)
+		case r.Method == http.MethodGet && r.URL.Path == "/groups/target/artifacts/orders/versions":
+			list := models.ArtifactVersionListResponse{Count: len(versions)}
+			for _, version := range versions {
+				list.Versions = append(list.Versions, models.ArtifactVersion{Version: version.Version})
+			}
+			err := json.NewEncoder(w).Encode(list)
+			assert.NoError(t, err)
+		case r.Method == http.MethodPost && r.URL.Path == "/groups/target/artifacts/orders/versions":
+			var request models.CreateVersionRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			for _, version := range versions {
+				assert.NotEqual(t, version.Version, request.Version, "version created twice")
+			}
+			versions = append(versions, request)
+			err := json.NewEncoder(w).Encode(models.ArtifactVersionDetailed{ArtifactVersion: models.ArtifactVersion{Version: request.Version}})
+			assert.NoError(t, err)
+		case r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/state"):
+			var body models.StateResponse
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			version := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/groups/target/artifacts/orders/versions/"), "/state")
+			states[version] = body.State
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer target.Close()
+
+	api := apis.NewArtifactsAPI(&client.Client{BaseURL: target.URL, HTTPClient: target.Client()})
+	assert.NoError(t, api.ImportArtifact(context.Background(), "target", *export, nil))
+	// A second import, e.g. a retry after a failure, skips the versions instead of duplicating them.
+	assert.NoError(t, api.ImportArtifact(context.Background(), "target", *export, nil))
+
+	if assert.NotNil(t, artifact) {
+		assert.Equal(t, "orders", artifact.ArtifactID)
+		assert.Equal(t, models.Json, artifact.ArtifactType)
+		assert.Equal(t, map[string]string{"team": "a"}, artifact.Labels)
+	}
+	if assert.Len(t, versions, 2) {
+		first, second := versions[0], versions[1]
+		assert.Equal(t, "1", first.Version)
+		assert.Equal(t, map[string]string{"stage": "old"}, first.Labels)
+		assert.Equal(t, `{"version": 1}`, first.Content.Content)
+
+		assert.Equal(t, "2", second.Version)
+		assert.Equal(t, "Orders v2", second.Name)
+		assert.Equal(t, `{"version": 1}`, second.Content.Content)
+		assert.Equal(t, export.Versions[1].References, second.Content.References)
+	}
+	assert.Equal(t, map[string]models.State{"1": models.StateDeprecated}, states)
+}
+
 func TestArtifactsAPI_ListArtifactRules(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockReferences := []models.Rule{models.RuleValidity, models.RuleCompatibility}
@@ -579,6 +1931,38 @@ func TestArtifactsAPI_ListArtifactRules(t *testing.T) {
 	})
 }
 
+func TestArtifactsAPI_ListArtifactRulesWithConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.WriteHeader(http.StatusOK)
+
+		var body interface{}
+		switch r.URL.Path {
+		case "/groups/test-group/artifacts/artifact-1/rules":
+			body = []models.Rule{models.RuleCompatibility, models.RuleValidity}
+		case "/groups/test-group/artifacts/artifact-1/rules/COMPATIBILITY":
+			body = models.GlobalRuleResponse{RuleType: models.RuleCompatibility, Config: models.CompatibilityLevelFull}
+		case "/groups/test-group/artifacts/artifact-1/rules/VALIDITY":
+			body = models.GlobalRuleResponse{RuleType: models.RuleValidity, Config: models.ValidityLevelSyntaxOnly}
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		err := json.NewEncoder(w).Encode(body)
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewArtifactsAPI(mockClient)
+
+	result, err := api.ListArtifactRulesWithConfig(context.Background(), "test-group", "artifact-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []models.GlobalRuleResponse{
+		{RuleType: models.RuleCompatibility, Config: models.CompatibilityLevelFull},
+		{RuleType: models.RuleValidity, Config: models.ValidityLevelSyntaxOnly},
+	}, result)
+}
+
 func TestArtifactsAPI_CreateArtifactRule(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -904,6 +2288,72 @@ func TestArtifactsAPI_UpdateArtifactRule(t *testing.T) {
 	})
 }
 
+func TestArtifactsAPI_UpdateArtifactRuleWithLevel(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRule := models.RuleValidity
+		// The server echoes the configuration it applied, which may differ from the requested one.
+		mockResponse := models.GlobalRuleResponse{
+			RuleType: mockRule,
+			Config:   models.ValidityLevelSyntaxOnly,
+		}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Contains(t, r.URL.Path, fmt.Sprintf("/groups/%s/artifacts/%s/rules/%s", stubGroupId, stubArtifactId, mockRule))
+			assert.Equal(t, http.MethodPut, r.Method)
+
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(mockResponse)
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+		level, err := api.UpdateArtifactRuleWithLevel(context.Background(), stubGroupId, stubArtifactId, mockRule, models.ValidityLevelFull)
+		assert.NoError(t, err)
+		assert.Equal(t, models.ValidityLevelSyntaxOnly, level)
+	})
+
+	t.Run("No Content", func(t *testing.T) {
+		mockRule := models.RuleValidity
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+		level, err := api.UpdateArtifactRuleWithLevel(context.Background(), stubGroupId, stubArtifactId, mockRule, models.ValidityLevelFull)
+		assert.NoError(t, err)
+		assert.Equal(t, models.ValidityLevelFull, level)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRule := models.RuleValidity
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Contains(t, r.URL.Path, fmt.Sprintf("/groups/%s/artifacts/%s/rules/%s", stubGroupId, stubArtifactId, mockRule))
+			assert.Equal(t, http.MethodPut, r.Method)
+
+			w.WriteHeader(http.StatusNotFound)
+			err := json.NewEncoder(w).Encode(models.APIError{Status: http.StatusNotFound, Title: TitleNotFound})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewArtifactsAPI(mockClient)
+		level, err := api.UpdateArtifactRuleWithLevel(context.Background(), stubGroupId, stubArtifactId, mockRule, models.ValidityLevelFull)
+		assert.Error(t, err)
+		assert.Empty(t, level)
+
+		var apiErr *models.APIError
+		ok := errors.As(err, &apiErr)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, apiErr.Status)
+		assert.Equal(t, TitleNotFound, apiErr.Title)
+	})
+}
+
 func TestArtifactsAPI_DeleteArtifactRule(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockRule := models.RuleValidity
@@ -1023,7 +2473,7 @@ func TestArtifactsAPIIntegration(t *testing.T) {
 	// Test ListArtifactReferences
 	t.Run("ListArtifactReferences", func(t *testing.T) {
 		contentID := int64(12345) // Replace with a valid content ID for your tests
-		_, err := artifactsAPI.ListArtifactReferences(ctx, contentID)
+		_, err := artifactsAPI.ListArtifactReferences(ctx, contentID, nil)
 		assert.Error(t, err) // Expect an error since no content ID exists
 	})
 
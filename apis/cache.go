@@ -0,0 +1,169 @@
+package apis
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"github.com/subzerobo/go-apicurio-sdk/client"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const defaultSchemaCacheSize = 1000
+
+// CachingVersionsAPI is a VersionsAPI that memoizes content lookups by global ID and content ID.
+// Content addressed by these identifiers is immutable, so cached entries are only evicted when
+// the cache is full (least recently used first) or when they are older than the configured TTL.
+// All other VersionsAPI methods are passed through unchanged.
+type CachingVersionsAPI struct {
+	*VersionsAPI
+	artifacts *ArtifactsAPI
+	cache     *contentCache
+}
+
+// NewCachingVersionsAPI creates a new CachingVersionsAPI instance.
+// A size <= 0 uses a default of 1000 entries, and a ttl <= 0 keeps entries until they are evicted.
+func NewCachingVersionsAPI(client *client.Client, size int, ttl time.Duration) *CachingVersionsAPI {
+	return WithSchemaCache(NewVersionsAPI(client), size, ttl)
+}
+
+// WithSchemaCache decorates an existing VersionsAPI with a content cache.
+func WithSchemaCache(api *VersionsAPI, size int, ttl time.Duration) *CachingVersionsAPI {
+	if size <= 0 {
+		size = defaultSchemaCacheSize
+	}
+	return &CachingVersionsAPI{
+		VersionsAPI: api,
+		artifacts:   NewArtifactsAPI(api.Client),
+		cache:       newContentCache(size, ttl, api.Client.Now),
+	}
+}
+
+// GetVersionContentByGlobalID returns the content for the given global ID, fetching it from the registry on a cache miss.
+func (api *CachingVersionsAPI) GetVersionContentByGlobalID(
+	ctx context.Context,
+	globalID int64,
+	params *models.ArtifactReferenceParams,
+) (*models.ArtifactContent, error) {
+	key := fmt.Sprintf("globalId:%d", globalID)
+	if params != nil {
+		key = fmt.Sprintf("%s:%s", key, params.HandleReferencesType)
+	}
+
+	return api.cache.getOrFetch(key, func() (*models.ArtifactContent, error) {
+		return api.VersionsAPI.GetVersionContentByGlobalID(ctx, globalID, params)
+	})
+}
+
+// GetArtifactContentByID returns the content for the given content ID, fetching it from the registry on a cache miss.
+func (api *CachingVersionsAPI) GetArtifactContentByID(ctx context.Context, contentID int64) (*models.ArtifactContent, error) {
+	key := fmt.Sprintf("contentId:%d", contentID)
+
+	return api.cache.getOrFetch(key, func() (*models.ArtifactContent, error) {
+		return api.artifacts.GetArtifactContentByID(ctx, contentID)
+	})
+}
+
+// Hits returns the number of lookups served from the cache.
+func (api *CachingVersionsAPI) Hits() uint64 {
+	return atomic.LoadUint64(&api.cache.hits)
+}
+
+// Misses returns the number of lookups that had to be fetched from the registry.
+func (api *CachingVersionsAPI) Misses() uint64 {
+	return atomic.LoadUint64(&api.cache.misses)
+}
+
+// contentCache is a size-bounded LRU cache of artifact content with an optional TTL.
+type contentCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	now     func() time.Time
+	order   *list.List
+	entries map[string]*list.Element
+	hits    uint64
+	misses  uint64
+}
+
+type contentCacheEntry struct {
+	key      string
+	content  models.ArtifactContent
+	storedAt time.Time
+}
+
+func newContentCache(size int, ttl time.Duration, now func() time.Time) *contentCache {
+	return &contentCache{
+		size:    size,
+		ttl:     ttl,
+		now:     now,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// getOrFetch returns a copy of the cached content for key, or calls fetch and caches its result.
+// Errors are never cached.
+func (c *contentCache) getOrFetch(key string, fetch func() (*models.ArtifactContent, error)) (*models.ArtifactContent, error) {
+	if content, ok := c.get(key); ok {
+		atomic.AddUint64(&c.hits, 1)
+		return content, nil
+	}
+	atomic.AddUint64(&c.misses, 1)
+
+	content, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	c.put(key, *content)
+
+	return content, nil
+}
+
+func (c *contentCache) get(key string) (*models.ArtifactContent, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*contentCacheEntry)
+	if c.ttl > 0 && c.now().Sub(entry.storedAt) > c.ttl {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	content := entry.content
+	return &content, true
+}
+
+func (c *contentCache) put(key string, content models.ArtifactContent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*contentCacheEntry)
+		entry.content = content
+		entry.storedAt = c.now()
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&contentCacheEntry{
+		key:      key,
+		content:  content,
+		storedAt: c.now(),
+	})
+
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*contentCacheEntry).key)
+	}
+}
@@ -0,0 +1,140 @@
+package apis_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/apis"
+	"github.com/subzerobo/go-apicurio-sdk/client"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newContentServer(t *testing.T, requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		w.Header().Set("X-Registry-ArtifactType", string(models.Avro))
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(stubContent))
+		assert.NoError(t, err)
+	}))
+}
+
+// manualClock is a client.Clock whose time only changes when the test sets it.
+type manualClock struct {
+	now time.Time
+}
+
+func (c *manualClock) Now() time.Time {
+	return c.now
+}
+
+func (c *manualClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.now = c.now.Add(d)
+	return ctx.Err()
+}
+
+func TestCachingVersionsAPI_GetVersionContentByGlobalID(t *testing.T) {
+	t.Run("SecondLookupIsCached", func(t *testing.T) {
+		var requests int32
+		server := newContentServer(t, &requests)
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewCachingVersionsAPI(mockClient, 10, time.Minute)
+
+		first, err := api.GetVersionContentByGlobalID(context.Background(), 1, nil)
+		assert.NoError(t, err)
+		second, err := api.GetVersionContentByGlobalID(context.Background(), 1, nil)
+		assert.NoError(t, err)
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+		assert.Equal(t, first, second)
+		assert.Equal(t, models.Avro, second.ArtifactType)
+		assert.Equal(t, uint64(1), api.Hits())
+		assert.Equal(t, uint64(1), api.Misses())
+	})
+
+	t.Run("TTLExpiryForcesRefetch", func(t *testing.T) {
+		var requests int32
+		server := newContentServer(t, &requests)
+		defer server.Close()
+
+		clock := &manualClock{now: time.Date(2024, 12, 10, 8, 0, 0, 0, time.UTC)}
+		mockClient := client.NewClient(server.URL, client.WithHTTPClient(server.Client()), client.WithClock(clock))
+		api := apis.NewCachingVersionsAPI(mockClient, 10, time.Hour)
+
+		_, err := api.GetVersionContentByGlobalID(context.Background(), 1, nil)
+		assert.NoError(t, err)
+		clock.now = clock.now.Add(59 * time.Minute)
+		_, err = api.GetVersionContentByGlobalID(context.Background(), 1, nil)
+		assert.NoError(t, err)
+		clock.now = clock.now.Add(2 * time.Minute)
+		_, err = api.GetVersionContentByGlobalID(context.Background(), 1, nil)
+		assert.NoError(t, err)
+
+		assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+		assert.Equal(t, uint64(1), api.Hits())
+		assert.Equal(t, uint64(2), api.Misses())
+	})
+
+	t.Run("LeastRecentlyUsedIsEvicted", func(t *testing.T) {
+		var requests int32
+		server := newContentServer(t, &requests)
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewCachingVersionsAPI(mockClient, 1, 0)
+
+		for _, globalID := range []int64{1, 2, 1} {
+			_, err := api.GetVersionContentByGlobalID(context.Background(), globalID, nil)
+			assert.NoError(t, err)
+		}
+
+		assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("ErrorsAreNotCached", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewCachingVersionsAPI(mockClient, 10, time.Minute)
+
+		for i := 0; i < 2; i++ {
+			content, err := api.GetVersionContentByGlobalID(context.Background(), 1, nil)
+			assert.ErrorIs(t, err, apis.ErrArtifactNotFound)
+			assert.Nil(t, content)
+		}
+
+		assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	})
+}
+
+func TestCachingVersionsAPI_GetArtifactContentByID(t *testing.T) {
+	var requests int32
+	server := newContentServer(t, &requests)
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewCachingVersionsAPI(mockClient, 10, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		content, err := api.GetArtifactContentByID(context.Background(), 7)
+		assert.NoError(t, err)
+		assert.Equal(t, stubContent, content.Content)
+	}
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	assert.Equal(t, uint64(2), api.Hits())
+	assert.Equal(t, uint64(1), api.Misses())
+}
@@ -0,0 +1,70 @@
+package apis
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// headerIdempotencyKey carries the key set with WithIdempotencyKey.
+const headerIdempotencyKey = "X-Idempotency-Key"
+
+type idempotencyKeyKey struct{}
+
+// WithIdempotencyKey returns a context that makes CreateArtifact and the CreateArtifactVersion methods
+// send key as the X-Idempotency-Key header, so that a proxy can recognize a retried create and avoid
+// creating a duplicate version.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyKey{}, key)
+}
+
+// idempotencyKey returns the key set with WithIdempotencyKey, or an empty string.
+func idempotencyKey(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyKey{}).(string)
+	return key
+}
+
+// Headers carrying the IDs set with WithPreservedIDs.
+const (
+	headerPreserveGlobalID  = "X-Registry-Preserve-GlobalId"
+	headerPreserveContentID = "X-Registry-Preserve-ContentId"
+)
+
+type preservedIDsKey struct{}
+
+type preservedIDs struct {
+	globalID, contentID int64
+}
+
+// WithPreservedIDs returns a context that makes CreateArtifact and the CreateArtifactVersion methods ask the
+// registry to keep the given global and content IDs, e.g. when migrating artifacts from another registry,
+// through the X-Registry-Preserve-GlobalId and X-Registry-Preserve-ContentId headers. A zero ID is not sent.
+func WithPreservedIDs(ctx context.Context, globalID, contentID int64) context.Context {
+	return context.WithValue(ctx, preservedIDsKey{}, preservedIDs{globalID: globalID, contentID: contentID})
+}
+
+// withPreservedIDs sets the headers for the IDs set with WithPreservedIDs, if any.
+func withPreservedIDs(ctx context.Context) requestOption {
+	ids, _ := ctx.Value(preservedIDsKey{}).(preservedIDs)
+	return func(req *http.Request) {
+		if ids.globalID != 0 {
+			req.Header.Set(headerPreserveGlobalID, strconv.FormatInt(ids.globalID, 10))
+		}
+		if ids.contentID != 0 {
+			req.Header.Set(headerPreserveContentID, strconv.FormatInt(ids.contentID, 10))
+		}
+	}
+}
+
+type groupKey struct{}
+
+// ContextWithGroup returns a context carrying groupID as the group used by GroupScopedArtifactsAPI.
+func ContextWithGroup(ctx context.Context, groupID string) context.Context {
+	return context.WithValue(ctx, groupKey{}, groupID)
+}
+
+// GroupFromContext returns the group set with ContextWithGroup, if any.
+func GroupFromContext(ctx context.Context) (string, bool) {
+	groupID, ok := ctx.Value(groupKey{}).(string)
+	return groupID, ok && groupID != ""
+}
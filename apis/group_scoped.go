@@ -0,0 +1,56 @@
+package apis
+
+import (
+	"context"
+	"github.com/subzerobo/go-apicurio-sdk/client"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+)
+
+// GroupScopedArtifactsAPI offers the ArtifactsAPI operations on a single group without a group argument.
+// The group is read from the context, set with ContextWithGroup; calls on a context without one fail with
+// ErrNoGroupInContext.
+type GroupScopedArtifactsAPI struct {
+	Client *client.Client
+}
+
+func NewGroupScopedArtifactsAPI(client *client.Client) *GroupScopedArtifactsAPI {
+	return &GroupScopedArtifactsAPI{
+		Client: client,
+	}
+}
+
+// ListArtifacts lists the artifacts in the context group. See ArtifactsAPI.ListArtifactsInGroup.
+func (api *GroupScopedArtifactsAPI) ListArtifacts(ctx context.Context, params *models.ListArtifactsInGroupParams) (*models.ListArtifactsResponse, error) {
+	groupID, ok := GroupFromContext(ctx)
+	if !ok {
+		return nil, ErrNoGroupInContext
+	}
+	return NewArtifactsAPI(api.Client).ListArtifactsInGroup(ctx, groupID, params)
+}
+
+// CreateArtifact creates an artifact in the context group. See ArtifactsAPI.CreateArtifact.
+func (api *GroupScopedArtifactsAPI) CreateArtifact(ctx context.Context, artifact models.CreateArtifactRequest, params *models.CreateArtifactParams) (*models.ArtifactDetail, error) {
+	groupID, ok := GroupFromContext(ctx)
+	if !ok {
+		return nil, ErrNoGroupInContext
+	}
+	return NewArtifactsAPI(api.Client).CreateArtifact(ctx, groupID, artifact, params)
+}
+
+// ArtifactExists reports whether the artifact exists in the context group. See ArtifactsAPI.ArtifactExists.
+func (api *GroupScopedArtifactsAPI) ArtifactExists(ctx context.Context, artifactId string) (bool, error) {
+	groupID, ok := GroupFromContext(ctx)
+	if !ok {
+		return false, ErrNoGroupInContext
+	}
+	return NewArtifactsAPI(api.Client).ArtifactExists(ctx, groupID, artifactId)
+}
+
+// DeleteArtifact deletes an artifact of the context group. See ArtifactsAPI.DeleteArtifact.
+func (api *GroupScopedArtifactsAPI) DeleteArtifact(ctx context.Context, artifactId string) error {
+	groupID, ok := GroupFromContext(ctx)
+	if !ok {
+		return ErrNoGroupInContext
+	}
+	return NewArtifactsAPI(api.Client).DeleteArtifact(ctx, groupID, artifactId)
+}
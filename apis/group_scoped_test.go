@@ -0,0 +1,67 @@
+package apis_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/apis"
+	"github.com/subzerobo/go-apicurio-sdk/client"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGroupScopedArtifactsAPI(t *testing.T) {
+	t.Run("Uses Context Group", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/groups/team-a/artifacts":
+				err := json.NewEncoder(w).Encode(models.ListArtifactsResponse{Count: 1, Artifacts: []models.SearchedArtifact{{GroupId: "team-a", ArtifactId: "orders"}}})
+				assert.NoError(t, err)
+			case r.Method == http.MethodGet && r.URL.Path == "/groups/team-a/artifacts/orders":
+				err := json.NewEncoder(w).Encode(models.ArtifactMetadata{})
+				assert.NoError(t, err)
+			case r.Method == http.MethodDelete && r.URL.Path == "/groups/team-a/artifacts/orders":
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewGroupScopedArtifactsAPI(mockClient)
+		ctx := apis.ContextWithGroup(context.Background(), "team-a")
+
+		list, err := api.ListArtifacts(ctx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "orders", list.Artifacts[0].ArtifactId)
+
+		exists, err := api.ArtifactExists(ctx, "orders")
+		assert.NoError(t, err)
+		assert.True(t, exists)
+
+		assert.NoError(t, api.DeleteArtifact(ctx, "orders"))
+	})
+
+	t.Run("No Group In Context", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewGroupScopedArtifactsAPI(mockClient)
+
+		_, err := api.ListArtifacts(context.Background(), nil)
+		assert.True(t, errors.Is(err, apis.ErrNoGroupInContext))
+
+		_, err = api.CreateArtifact(apis.ContextWithGroup(context.Background(), ""), models.CreateArtifactRequest{}, nil)
+		assert.True(t, errors.Is(err, apis.ErrNoGroupInContext))
+
+		err = api.DeleteArtifact(context.Background(), "orders")
+		assert.True(t, errors.Is(err, apis.ErrNoGroupInContext))
+	})
+}
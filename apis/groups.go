@@ -0,0 +1,118 @@
+package apis
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/subzerobo/go-apicurio-sdk/client"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"net/http"
+)
+
+type GroupsAPI struct {
+	Client *client.Client
+}
+
+func NewGroupsAPI(client *client.Client) *GroupsAPI {
+	return &GroupsAPI{
+		Client: client,
+	}
+}
+
+// ListGroups Returns a page of the groups in the registry.
+// GET /groups
+// See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Groups/operation/listGroups
+func (api *GroupsAPI) ListGroups(ctx context.Context, params *models.ListGroupsParams) (*models.ListGroupsResponse, error) {
+	query := ""
+	if params != nil {
+		if err := validateParams(params); err != nil {
+			return nil, err
+		}
+		query = "?" + params.ToQuery().Encode()
+	}
+
+	url := api.Client.URL("groups") + query
+	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result models.ListGroupsResponse
+	if err := handleResponse(resp, http.StatusOK, &result, api.Client.StrictDecoding); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListGroupsWithCounts returns the page of groups selected by params, each with the number of artifacts it
+// contains, e.g. for dashboards. The group listing carries no counts, so they are fetched concurrently, one
+// request per group. Groups are returned in the order ListGroups lists them.
+func (api *GroupsAPI) ListGroupsWithCounts(ctx context.Context, params *models.ListGroupsParams) ([]models.GroupSummary, error) {
+	page, err := api.ListGroups(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts := NewArtifactsAPI(api.Client)
+	results := make([]models.GroupSummary, len(page.Groups))
+	errs := forEachBounded(ctx, len(page.Groups), bulkConcurrency, func(ctx context.Context, i int) error {
+		results[i].SearchedGroup = page.Groups[i]
+		// Only the total count is needed, so a single artifact is listed.
+		list, err := artifacts.ListArtifactsInGroup(ctx, page.Groups[i].GroupId, &models.ListArtifactsInGroupParams{Limit: 1})
+		if err != nil {
+			return err
+		}
+		results[i].ArtifactCount = list.Count
+		return nil
+	})
+	for i, err := range errs {
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to count artifacts in group %s", page.Groups[i].GroupId)
+		}
+	}
+
+	return results, nil
+}
+
+// executeRequest handles the creation and execution of an HTTP request.
+func (api *GroupsAPI) executeRequest(ctx context.Context, method, url string, body interface{}) (*http.Response, error) {
+	var reqBody []byte
+	var err error
+	contentType := "*/*"
+
+	switch v := body.(type) {
+	case string:
+		reqBody = []byte(v)
+		contentType = "*/*"
+	case []byte:
+		reqBody = v
+		contentType = "*/*"
+	default:
+		contentType = "application/json"
+		reqBody, err = json.Marshal(body)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal request body as JSON")
+		}
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create HTTP request")
+	}
+
+	// Set appropriate Content-Type header
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	// Execute the request
+	resp, err := api.Client.Do(req)
+	if err != nil {
+		return nil, requestError(ctx, err)
+	}
+
+	return resp, nil
+}
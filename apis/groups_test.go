@@ -0,0 +1,118 @@
+package apis_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/apis"
+	"github.com/subzerobo/go-apicurio-sdk/client"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGroupsAPI_ListGroups(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockResponse := models.ListGroupsResponse{
+			Groups: []models.SearchedGroup{{GroupId: "com.acme", Owner: "alice"}},
+			Count:  1,
+		}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/groups", r.URL.Path)
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "5", r.URL.Query().Get("limit"))
+			assert.Equal(t, "groupId", r.URL.Query().Get("orderby"))
+
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(mockResponse)
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewGroupsAPI(mockClient)
+
+		result, err := api.ListGroups(context.Background(), &models.ListGroupsParams{Limit: 5, OrderBy: models.OrderByGroupId})
+		assert.NoError(t, err)
+		assert.Equal(t, &mockResponse, result)
+	})
+
+	t.Run("Invalid Params", func(t *testing.T) {
+		api := apis.NewGroupsAPI(&client.Client{BaseURL: "http://localhost"})
+
+		_, err := api.ListGroups(context.Background(), &models.ListGroupsParams{OrderBy: models.OrderByName})
+		assert.ErrorIs(t, err, apis.ErrInvalidArgument)
+	})
+}
+
+func TestGroupsAPI_ListGroupsWithCounts(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+
+			var response interface{}
+			switch r.URL.Path {
+			case "/groups":
+				response = models.ListGroupsResponse{
+					Groups: []models.SearchedGroup{{GroupId: "com.acme"}, {GroupId: "org.example"}},
+					Count:  2,
+				}
+			case "/groups/com.acme/artifacts":
+				assert.Equal(t, "1", r.URL.Query().Get("limit"))
+				response = models.ListArtifactsResponse{Artifacts: []models.SearchedArtifact{{ArtifactId: "orders"}}, Count: 3}
+			case "/groups/org.example/artifacts":
+				assert.Equal(t, "1", r.URL.Query().Get("limit"))
+				response = models.ListArtifactsResponse{Count: 0}
+			default:
+				t.Errorf("unexpected request to %s", r.URL.Path)
+			}
+
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(response)
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewGroupsAPI(mockClient)
+
+		result, err := api.ListGroupsWithCounts(context.Background(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []models.GroupSummary{
+			{SearchedGroup: models.SearchedGroup{GroupId: "com.acme"}, ArtifactCount: 3},
+			{SearchedGroup: models.SearchedGroup{GroupId: "org.example"}, ArtifactCount: 0},
+		}, result)
+	})
+
+	t.Run("Count Fails", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/groups" {
+				w.WriteHeader(http.StatusOK)
+				err := json.NewEncoder(w).Encode(models.ListGroupsResponse{
+					Groups: []models.SearchedGroup{{GroupId: "com.acme"}},
+					Count:  1,
+				})
+				assert.NoError(t, err)
+				return
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+			err := json.NewEncoder(w).Encode(models.APIError{Status: http.StatusInternalServerError, Title: TitleInternalServerError})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewGroupsAPI(mockClient)
+
+		result, err := api.ListGroupsWithCounts(context.Background(), nil)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "com.acme")
+
+		var apiErr *models.APIError
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusInternalServerError, apiErr.Status)
+	})
+}
@@ -1,13 +1,22 @@
 package apis
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"github.com/pkg/errors"
+	"github.com/subzerobo/go-apicurio-sdk/client"
 	"github.com/subzerobo/go-apicurio-sdk/models"
 	"io"
 	"net/http"
+	"net/url"
 	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 )
 
 const (
@@ -15,11 +24,39 @@ const (
 	ContentTypeAll  = "*/*"
 )
 
+// maxDrainBytes bounds how much of an unread response body is discarded to allow connection reuse.
+const maxDrainBytes = 64 << 10
+
+// bulkConcurrency bounds the number of in-flight requests issued by the bulk helpers.
+const bulkConcurrency = 4
+
+// defaultExportMaxVersions bounds the number of versions ExportArtifact downloads unless the caller sets another limit.
+const defaultExportMaxVersions = 1000
+
+// listPageSize is the page size used by helpers that fetch every page of a list.
+const listPageSize = 100
+
 var (
 	regexGroupIDArtifactID = regexp.MustCompile(`^.{1,512}$`)
-	regexVersion           = regexp.MustCompile(`[a-zA-Z0-9._\-+]{1,256}`)
+	regexCommentID         = regexp.MustCompile(`^\S{1,128}$`)
 )
 
+// headerArtifactType asserts the artifact type on create, for content whose type the registry cannot
+// detect unambiguously (e.g. KCONNECT vs JSON). The registry also reports the type of content it returns in it.
+const headerArtifactType = "X-Registry-ArtifactType"
+
+// requestOption customizes an outgoing request before it is sent.
+type requestOption func(req *http.Request)
+
+// withHeader sets a header on the request when value is not empty.
+func withHeader(key, value string) requestOption {
+	return func(req *http.Request) {
+		if value != "" {
+			req.Header.Set(key, value)
+		}
+	}
+}
+
 // ErrInvalidInput is returned when an input validation fails.
 func validateInput(input string, regex *regexp.Regexp, name string) error {
 	if match := regex.MatchString(input); !match {
@@ -28,33 +65,154 @@ func validateInput(input string, regex *regexp.Regexp, name string) error {
 	return nil
 }
 
-// parseAPIError parses an API error response and returns an APIError struct.
+// validateCommentID rejects comment IDs that are empty, longer than 128 characters, or contain whitespace.
+func validateCommentID(commentId string) error {
+	if !regexCommentID.MatchString(commentId) {
+		return errors.Wrapf(ErrInvalidArgument, "Comment ID %q must be 1 to 128 characters without whitespace", commentId)
+	}
+	return nil
+}
+
+// validateVersionExpression rejects version expressions the versions endpoints do not accept.
+func validateVersionExpression(versionExpression string) error {
+	if err := models.VersionExpression(versionExpression).Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidArgument, "Version Expression: %v", err)
+	}
+	return nil
+}
+
+// validateRule rejects rule types unknown to the registry.
+func validateRule(rule models.Rule) error {
+	if !rule.IsValid() {
+		return errors.Wrapf(ErrInvalidArgument, "Rule: unknown rule type %q", rule)
+	}
+	return nil
+}
+
+// validateParams reports a failed parameter validation as ErrInvalidArgument.
+func validateParams(params interface{ Validate() error }) error {
+	if err := params.Validate(); err != nil {
+		return errors.Wrap(ErrInvalidArgument, err.Error())
+	}
+	return nil
+}
+
+// validateArtifactType rejects artifact types unknown to the SDK unless the client allows them.
+func validateArtifactType(c *client.Client, artifactType models.ArtifactType) error {
+	if c.AllowUnknownArtifactTypes {
+		return nil
+	}
+	if _, err := models.ParseArtifactType(string(artifactType)); err != nil {
+		return errors.Wrapf(err, "artifact type %q", artifactType)
+	}
+	return nil
+}
+
+// validateJSONSchemaContent runs the opt-in local JSON Schema check for JSON artifacts.
+func validateJSONSchemaContent(c *client.Client, artifactType models.ArtifactType, content string) error {
+	if !c.ValidateJSONSchemas || artifactType != models.Json {
+		return nil
+	}
+	if err := models.ValidateJSONSchema([]byte(content)); err != nil {
+		return errors.Wrap(err, "content failed local validation")
+	}
+	return nil
+}
+
+// parseAPIError decodes the problem details of an error response. An empty body, as sent by proxies or for
+// HEAD requests, yields an APIError carrying only the response status. The body is kept in APIError.Raw, so
+// that a body that is not problem details, e.g. an HTML page from a gateway, is not lost.
 func parseAPIError(resp *http.Response) (*models.APIError, error) {
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read error response body: %w", err)
 	}
 
-	var apiError models.APIError
+	apiError := models.APIError{Status: resp.StatusCode, Title: http.StatusText(resp.StatusCode)}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return &apiError, nil
+	}
 	if err := json.Unmarshal(body, &apiError); err != nil {
-		return nil, fmt.Errorf("failed to parse error response: %w", err)
+		apiError = models.APIError{Status: resp.StatusCode, Title: http.StatusText(resp.StatusCode)}
+	}
+	if apiError.Status == 0 {
+		apiError.Status = resp.StatusCode
 	}
+	apiError.Raw = body
 
 	return &apiError, nil
 }
 
+// ruleViolationReasons lists the rule violations of a rejected create, falling back to the error detail
+// when the server did not report individual violations.
+func ruleViolationReasons(apiErr *models.APIError) []string {
+	var reasons []string
+	for _, cause := range apiErr.Causes {
+		reason := cause.Description
+		if cause.Context != "" {
+			reason = fmt.Sprintf("%s (at %s)", reason, cause.Context)
+		}
+		reasons = append(reasons, reason)
+	}
+	if len(reasons) == 0 && apiErr.Detail != "" {
+		reasons = append(reasons, apiErr.Detail)
+	}
+	return reasons
+}
+
 func parseArtifactTypeHeader(resp *http.Response) (models.ArtifactType, error) {
-	artifactTypeHeader := resp.Header.Get("X-Registry-ArtifactType")
-	artifactType, err := models.ParseArtifactType(artifactTypeHeader)
+	artifactTypeHeader := resp.Header.Get(headerArtifactType)
+	artifactType, err := models.ParseArtifactTypeFold(artifactTypeHeader)
 	if err != nil {
 		return "", errors.Wrapf(err, "invalid artifact type in response header: %s", artifactTypeHeader)
 	}
 	return artifactType, nil
 }
 
-// handleResponse reads the response body and checks the status code.
-func handleResponse(resp *http.Response, expectedStatus int, result interface{}) error {
-	defer resp.Body.Close()
+// drainAndClose discards what is left of a response body before closing it, so that the underlying
+// connection can be reused. Bodies larger than maxDrainBytes are closed without being fully read.
+func drainAndClose(body io.ReadCloser) {
+	_, _ = io.CopyN(io.Discard, body, maxDrainBytes)
+	_ = body.Close()
+}
+
+// gzipBody closes both the gzip reader and the response body it decompresses.
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (b *gzipBody) Close() error {
+	_ = b.Reader.Close()
+	return b.body.Close()
+}
+
+// decompressBody replaces a gzip-encoded response body with its decompressed content. The transport only
+// does this itself when it added Accept-Encoding, not when the caller set it, e.g. through client.WithHeaders.
+func decompressBody(resp *http.Response) error {
+	if resp.Uncompressed || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+	reader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to decompress response body")
+	}
+	resp.Body = &gzipBody{Reader: reader, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// handleResponse reads the response body and checks the status code. With strict set, as by the client's
+// StrictDecoding, decoding into result fails on fields result does not know.
+func handleResponse(resp *http.Response, expectedStatus int, result interface{}, strict bool) error {
+	if err := decompressBody(resp); err != nil {
+		drainAndClose(resp.Body)
+		return err
+	}
+	defer drainAndClose(resp.Body)
 
 	if resp.StatusCode != expectedStatus {
 		apiError, parseErr := parseAPIError(resp)
@@ -65,7 +223,11 @@ func handleResponse(resp *http.Response, expectedStatus int, result interface{})
 	}
 
 	if result != nil && resp.StatusCode == expectedStatus {
-		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		decoder := json.NewDecoder(resp.Body)
+		if strict {
+			decoder.DisallowUnknownFields()
+		}
+		if err := decoder.Decode(result); err != nil {
 			return errors.Wrap(err, "failed to parse response body")
 		}
 	}
@@ -73,9 +235,24 @@ func handleResponse(resp *http.Response, expectedStatus int, result interface{})
 	return nil
 }
 
+// handleUpdateResponse handles the response to an update the registry answers with 200 and the updated
+// resource. Some deployments answer 204 instead, sometimes with a body added by a proxy; result is then left
+// as is and the body is discarded.
+func handleUpdateResponse(resp *http.Response, result interface{}, strict bool) error {
+	if resp.StatusCode == http.StatusNoContent {
+		drainAndClose(resp.Body)
+		return nil
+	}
+	return handleResponse(resp, http.StatusOK, result, strict)
+}
+
 // handleRawResponse reads the response body and checks the status code.
 func handleRawResponse(resp *http.Response, expectedStatus int) (string, error) {
-	defer resp.Body.Close()
+	if err := decompressBody(resp); err != nil {
+		drainAndClose(resp.Body)
+		return "", err
+	}
+	defer drainAndClose(resp.Body)
 	if resp.StatusCode != expectedStatus {
 		apiError, parseErr := parseAPIError(resp)
 		if parseErr != nil {
@@ -91,3 +268,103 @@ func handleRawResponse(resp *http.Response, expectedStatus int) (string, error)
 
 	return string(content), nil
 }
+
+// requestError wraps an error returned by client.Client.Do. When ctx is done the result matches ctx.Err() with
+// errors.Is, even if a custom Doer or request editor reported the cancellation with an error of its own, so
+// callers can tell a timeout from a failure of the registry.
+func requestError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil && !stderrors.Is(err, ctxErr) {
+		err = fmt.Errorf("%w: %v", ctxErr, err)
+	}
+	return errors.Wrap(err, "failed to execute HTTP request")
+}
+
+// registryDefaultGroup is the group the registry assigns to artifacts and references without a group ID.
+const registryDefaultGroup = "default"
+
+// orDefaultGroup returns groupID, or defaultGroup when groupID is empty.
+func orDefaultGroup(groupID, defaultGroup string) string {
+	if groupID == "" {
+		return defaultGroup
+	}
+	return groupID
+}
+
+// joinErrors combines the errors of a bulk operation, ignoring nil ones, into one error that errors.Is and
+// errors.As match against each of them. It returns nil when all errors are nil.
+func joinErrors(errs []error) error {
+	return stderrors.Join(errs...)
+}
+
+// forEachBounded calls fn for every index in [0, n) with at most concurrency calls in flight and
+// returns the per-index errors. Once ctx is done no further calls are started and the remaining
+// indexes report the context error.
+func forEachBounded(ctx context.Context, n, concurrency int, fn func(ctx context.Context, i int) error) []error {
+	errs := make([]error, n)
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		if ctx.Err() == nil {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			for j := i; j < n; j++ {
+				errs[j] = err
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = fn(ctx, i)
+		}(i)
+	}
+
+	wg.Wait()
+	return errs
+}
+
+// applyVersionLocation fills the identifiers missing from a created version using the Location header of
+// the response, e.g. ".../groups/{groupId}/artifacts/{artifactId}/versions/{version}" or ".../ids/globalIds/{globalId}".
+func applyVersionLocation(version *models.ArtifactVersionDetailed, location string) {
+	parsed, err := url.Parse(location)
+	if location == "" || err != nil {
+		return
+	}
+
+	segments := strings.Split(parsed.EscapedPath(), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		value, err := url.PathUnescape(segments[i+1])
+		if err != nil || value == "" {
+			continue
+		}
+		switch segments[i] {
+		case "groups":
+			if version.GroupID == "" {
+				version.GroupID = value
+			}
+		case "artifacts":
+			if version.ArtifactID == "" {
+				version.ArtifactID = value
+			}
+		case "versions":
+			if version.Version == "" && models.VersionExpression(value).Validate() == nil {
+				version.Version = value
+			}
+		case "globalIds":
+			if id, err := strconv.ParseInt(value, 10, 64); err == nil && version.GlobalID == 0 {
+				version.GlobalID = id
+			}
+		case "contentIds":
+			if id, err := strconv.ParseInt(value, 10, 64); err == nil && version.ContentID == 0 {
+				version.ContentID = id
+			}
+		}
+	}
+}
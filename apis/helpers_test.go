@@ -0,0 +1,49 @@
+package apis_test
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/apis"
+	"github.com/subzerobo/go-apicurio-sdk/client"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// abortingDoer reports every request as aborted without mentioning the context, as some custom transports do.
+type abortingDoer struct{}
+
+func (abortingDoer) Do(*http.Request) (*http.Response, error) {
+	return nil, errors.New("request aborted")
+}
+
+func TestExecuteRequest_ContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for name, c := range map[string]*client.Client{
+		"HTTP Client": {BaseURL: server.URL, HTTPClient: server.Client()},
+		"Custom Doer": {BaseURL: server.URL, Doer: abortingDoer{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := apis.NewArtifactsAPI(c).ListArtifactsInGroup(ctx, stubGroupId, nil)
+			assert.ErrorIs(t, err, context.Canceled)
+			_, err = apis.NewVersionsAPI(c).ListArtifactVersions(ctx, stubGroupId, stubArtifactId, nil)
+			assert.ErrorIs(t, err, context.Canceled)
+			_, err = apis.NewMetadataAPI(c).GetArtifactMetadata(ctx, stubGroupId, stubArtifactId)
+			assert.ErrorIs(t, err, context.Canceled)
+			_, err = apis.NewGroupsAPI(c).ListGroups(ctx, nil)
+			assert.ErrorIs(t, err, context.Canceled)
+			_, err = apis.NewAdminAPI(c).ListGlobalRules(ctx)
+			assert.ErrorIs(t, err, context.Canceled)
+			err = apis.NewSystemAPI(c).Ping(ctx)
+			assert.ErrorIs(t, err, context.Canceled)
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"github.com/pkg/errors"
 	"github.com/subzerobo/go-apicurio-sdk/client"
 	"github.com/subzerobo/go-apicurio-sdk/models"
@@ -14,6 +13,9 @@ import (
 // MetadataAPI handles metadata-related operations for artifacts.
 type MetadataAPI struct {
 	Client *client.Client
+
+	// DefaultGroup, when set, is used by the methods taking a group ID when they are called with an empty one.
+	DefaultGroup string
 }
 
 // NewMetadataAPI creates a new MetadataAPI instance.
@@ -24,80 +26,119 @@ func NewMetadataAPI(client *client.Client) *MetadataAPI {
 }
 
 // GetArtifactVersionMetadata retrieves metadata for a single artifact version.
+// versionExpression may also select a version through a branch, e.g. models.LatestVersion().String().
 func (api *MetadataAPI) GetArtifactVersionMetadata(ctx context.Context, groupId, artifactId, versionExpression string) (*models.ArtifactVersionMetadata, error) {
+	metadata, _, err := api.GetArtifactVersionMetadataWithETag(ctx, groupId, artifactId, versionExpression)
+	return metadata, err
+}
+
+// GetArtifactVersionMetadataWithETag retrieves metadata for a single artifact version together with its ETag,
+// which can be passed to UpdateArtifactVersionMetadataIfMatch. The ETag is empty if the server does not issue one.
+func (api *MetadataAPI) GetArtifactVersionMetadataWithETag(ctx context.Context, groupId, artifactId, versionExpression string) (*models.ArtifactVersionMetadata, string, error) {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
 	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	if err := validateInput(versionExpression, regexVersion, "Version Expression"); err != nil {
-		return nil, err
+	if err := validateVersionExpression(versionExpression); err != nil {
+		return nil, "", err
 	}
 
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s/versions/%s", api.Client.BaseURL, groupId, artifactId, versionExpression)
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId, "versions", versionExpression)
 
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	var metadata models.ArtifactVersionMetadata
-	if err := handleResponse(resp, http.StatusOK, &metadata); err != nil {
-		return nil, err
+	if err := handleResponse(resp, http.StatusOK, &metadata, api.Client.StrictDecoding); err != nil {
+		return nil, "", err
 	}
 
-	return &metadata, nil
+	return &metadata, resp.Header.Get("ETag"), nil
 }
 
 // UpdateArtifactVersionMetadata updates the user-editable metadata of an artifact version.
 func (api *MetadataAPI) UpdateArtifactVersionMetadata(ctx context.Context, groupId, artifactId, versionExpression string, metadata models.UpdateArtifactMetadataRequest) error {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
 	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return err
 	}
 	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
 		return err
 	}
-	if err := validateInput(versionExpression, regexVersion, "Version Expression"); err != nil {
+	if err := validateVersionExpression(versionExpression); err != nil {
 		return err
 	}
 
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s/versions/%s", api.Client.BaseURL, groupId, artifactId, versionExpression)
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId, "versions", versionExpression)
 
 	resp, err := api.executeRequest(ctx, http.MethodPut, url, metadata)
 	if err != nil {
 		return err
 	}
 
-	return handleResponse(resp, http.StatusNoContent, nil)
+	return handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding)
+}
+
+// UpdateArtifactVersionMetadataIfMatch updates the user-editable metadata of an artifact version only if it
+// still matches the ETag returned by GetArtifactVersionMetadataWithETag. It returns ErrPreconditionFailed
+// when the metadata was modified in the meantime.
+func (api *MetadataAPI) UpdateArtifactVersionMetadataIfMatch(ctx context.Context, groupId, artifactId, versionExpression string, metadata models.UpdateArtifactMetadataRequest, ifMatch string) error {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
+	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
+		return err
+	}
+	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
+		return err
+	}
+	if err := validateVersionExpression(versionExpression); err != nil {
+		return err
+	}
+
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId, "versions", versionExpression)
+
+	return api.updateMetadataIfMatch(ctx, url, metadata, ifMatch)
 }
 
 // GetArtifactMetadata retrieves metadata for an artifact based on the latest version or the next available non-disabled version.
 func (api *MetadataAPI) GetArtifactMetadata(ctx context.Context, groupId, artifactId string) (*models.ArtifactMetadata, error) {
+	metadata, _, err := api.GetArtifactMetadataWithETag(ctx, groupId, artifactId)
+	return metadata, err
+}
+
+// GetArtifactMetadataWithETag retrieves metadata for an artifact together with its ETag,
+// which can be passed to UpdateArtifactMetadataIfMatch. The ETag is empty if the server does not issue one.
+func (api *MetadataAPI) GetArtifactMetadataWithETag(ctx context.Context, groupId, artifactId string) (*models.ArtifactMetadata, string, error) {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
 	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s", api.Client.BaseURL, groupId, artifactId)
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId)
 
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	var metadata models.ArtifactMetadata
-	if err := handleResponse(resp, http.StatusOK, &metadata); err != nil {
-		return nil, err
+	if err := handleResponse(resp, http.StatusOK, &metadata, api.Client.StrictDecoding); err != nil {
+		return nil, "", err
 	}
 
-	return &metadata, nil
+	return &metadata, resp.Header.Get("ETag"), nil
 }
 
 // UpdateArtifactMetadata updates the editable parts of an artifact's metadata.
 func (api *MetadataAPI) UpdateArtifactMetadata(ctx context.Context, groupId, artifactId string, metadata models.UpdateArtifactMetadataRequest) error {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
 	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return err
 	}
@@ -106,18 +147,63 @@ func (api *MetadataAPI) UpdateArtifactMetadata(ctx context.Context, groupId, art
 	}
 
 	// Construct the URL
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s", api.Client.BaseURL, groupId, artifactId)
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId)
 
 	resp, err := api.executeRequest(ctx, http.MethodPut, url, metadata)
 	if err != nil {
 		return err
 	}
 
-	return handleResponse(resp, http.StatusNoContent, nil)
+	return handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding)
+}
+
+// TransferArtifactOwnership changes the owner of an artifact, leaving the rest of its metadata untouched.
+func (api *MetadataAPI) TransferArtifactOwnership(ctx context.Context, groupId, artifactId, newOwner string) error {
+	if newOwner == "" {
+		return errors.Wrap(ErrInvalidArgument, "New Owner: must not be empty")
+	}
+
+	return api.UpdateArtifactMetadata(ctx, groupId, artifactId, models.UpdateArtifactMetadataRequest{Owner: newOwner})
+}
+
+// UpdateArtifactMetadataIfMatch updates the editable parts of an artifact's metadata only if it still matches
+// the ETag returned by GetArtifactMetadataWithETag. It returns ErrPreconditionFailed when the metadata
+// was modified in the meantime.
+func (api *MetadataAPI) UpdateArtifactMetadataIfMatch(ctx context.Context, groupId, artifactId string, metadata models.UpdateArtifactMetadataRequest, ifMatch string) error {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
+	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
+		return err
+	}
+	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
+		return err
+	}
+
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId)
+
+	return api.updateMetadataIfMatch(ctx, url, metadata, ifMatch)
+}
+
+// updateMetadataIfMatch sends a conditional metadata update with an If-Match header.
+func (api *MetadataAPI) updateMetadataIfMatch(ctx context.Context, url string, metadata models.UpdateArtifactMetadataRequest, ifMatch string) error {
+	if ifMatch == "" {
+		return errors.Wrap(ErrInvalidArgument, "If-Match: must not be empty")
+	}
+
+	resp, err := api.executeRequest(ctx, http.MethodPut, url, metadata, withHeader("If-Match", ifMatch))
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		drainAndClose(resp.Body)
+		return errors.Wrapf(ErrPreconditionFailed, "If-Match: %s", ifMatch)
+	}
+
+	return handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding)
 }
 
 // executeRequest executes an HTTP request with the given method, URL, and body.
-func (api *MetadataAPI) executeRequest(ctx context.Context, method, url string, body interface{}) (*http.Response, error) {
+func (api *MetadataAPI) executeRequest(ctx context.Context, method, url string, body interface{}, opts ...requestOption) (*http.Response, error) {
 	var reqBody []byte
 	var err error
 	contentType := "*/*"
@@ -147,11 +233,14 @@ func (api *MetadataAPI) executeRequest(ctx context.Context, method, url string,
 	if body != nil {
 		req.Header.Set("Content-Type", contentType)
 	}
+	for _, opt := range opts {
+		opt(req)
+	}
 
 	// Execute the request
 	resp, err := api.Client.Do(req)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to execute HTTP request")
+		return nil, requestError(ctx, err)
 	}
 
 	return resp, nil
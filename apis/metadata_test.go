@@ -194,6 +194,195 @@ func TestUpdateArtifactMetadata(t *testing.T) {
 }
 
 /***********************/
+func TestTransferArtifactOwnership(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "/groups/test-group/artifacts/artifact-1", r.URL.Path)
+
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]interface{}{"owner": "new-owner"}, body)
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewMetadataAPI(mockClient)
+
+		err := api.TransferArtifactOwnership(context.Background(), "test-group", "artifact-1", "new-owner")
+		assert.NoError(t, err)
+	})
+
+	t.Run("EmptyOwner", func(t *testing.T) {
+		api := apis.NewMetadataAPI(&client.Client{})
+
+		err := api.TransferArtifactOwnership(context.Background(), "test-group", "artifact-1", "")
+		assert.ErrorIs(t, err, apis.ErrInvalidArgument)
+	})
+}
+
+func TestArtifactMetadata_OptimisticConcurrency(t *testing.T) {
+	const etag = `"v1"`
+
+	newServer := func(t *testing.T, path string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, path, r.URL.Path)
+			switch r.Method {
+			case http.MethodGet:
+				w.Header().Set("ETag", etag)
+				w.WriteHeader(http.StatusOK)
+				err := json.NewEncoder(w).Encode(models.ArtifactMetadata{})
+				assert.NoError(t, err)
+			case http.MethodPut:
+				if r.Header.Get("If-Match") != etag {
+					w.WriteHeader(http.StatusPreconditionFailed)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}
+		}))
+	}
+
+	t.Run("Artifact", func(t *testing.T) {
+		server := newServer(t, "/groups/test-group/artifacts/artifact-1")
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewMetadataAPI(mockClient)
+
+		metadata, tag, err := api.GetArtifactMetadataWithETag(context.Background(), "test-group", "artifact-1")
+		assert.NoError(t, err)
+		assert.NotNil(t, metadata)
+		assert.Equal(t, etag, tag)
+
+		update := models.UpdateArtifactMetadataRequest{Name: "Updated"}
+		err = api.UpdateArtifactMetadataIfMatch(context.Background(), "test-group", "artifact-1", update, tag)
+		assert.NoError(t, err)
+
+		err = api.UpdateArtifactMetadataIfMatch(context.Background(), "test-group", "artifact-1", update, `"stale"`)
+		assert.ErrorIs(t, err, apis.ErrPreconditionFailed)
+
+		err = api.UpdateArtifactMetadataIfMatch(context.Background(), "test-group", "artifact-1", update, "")
+		assert.ErrorIs(t, err, apis.ErrInvalidArgument)
+	})
+
+	t.Run("Version", func(t *testing.T) {
+		server := newServer(t, "/groups/test-group/artifacts/artifact-1/versions/1.0")
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewMetadataAPI(mockClient)
+
+		metadata, tag, err := api.GetArtifactVersionMetadataWithETag(context.Background(), "test-group", "artifact-1", "1.0")
+		assert.NoError(t, err)
+		assert.NotNil(t, metadata)
+		assert.Equal(t, etag, tag)
+
+		update := models.UpdateArtifactMetadataRequest{Description: "Updated"}
+		err = api.UpdateArtifactVersionMetadataIfMatch(context.Background(), "test-group", "artifact-1", "1.0", update, tag)
+		assert.NoError(t, err)
+
+		err = api.UpdateArtifactVersionMetadataIfMatch(context.Background(), "test-group", "artifact-1", "1.0", update, `"stale"`)
+		assert.ErrorIs(t, err, apis.ErrPreconditionFailed)
+	})
+}
+
+func TestMetadataAPI_PathEscaping(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/groups/my group/artifacts/com/example/Foo", r.URL.Path)
+		assert.Equal(t, "/groups/my%20group/artifacts/com%2Fexample%2FFoo", r.URL.EscapedPath())
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(models.ArtifactMetadata{BaseMetadata: models.BaseMetadata{ArtifactID: "com/example/Foo"}})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewMetadataAPI(mockClient)
+
+	metadata, err := api.GetArtifactMetadata(context.Background(), "my group", "com/example/Foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "com/example/Foo", metadata.ArtifactID)
+}
+
+func TestMetadataAPI_GetArtifactVersionMetadata_Expressions(t *testing.T) {
+	tests := []struct {
+		name        string
+		expression  string
+		escapedPath string
+	}{
+		{"Latest", models.LatestVersion().String(), "/groups/test-group/artifacts/test-artifact/versions/branch=latest"},
+		{"Branch", models.BranchVersion("release-1.x").String(), "/groups/test-group/artifacts/test-artifact/versions/branch=release-1.x"},
+		{"Version", "latest", "/groups/test-group/artifacts/test-artifact/versions/latest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.escapedPath, r.URL.EscapedPath())
+				w.WriteHeader(http.StatusOK)
+				err := json.NewEncoder(w).Encode(models.ArtifactVersionMetadata{Version: "2.0.0"})
+				assert.NoError(t, err)
+			}))
+			defer server.Close()
+
+			mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+			api := apis.NewMetadataAPI(mockClient)
+
+			metadata, err := api.GetArtifactVersionMetadata(context.Background(), "test-group", "test-artifact", tt.expression)
+			assert.NoError(t, err)
+			assert.Equal(t, "2.0.0", metadata.Version)
+		})
+	}
+}
+
+func TestMetadataAPI_StrictDecoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"groupId": "test-group", "artifactId": "test-artifact", "version": "1.0.0", "retentionDays": 30}`))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	t.Run("Lenient By Default", func(t *testing.T) {
+		api := apis.NewMetadataAPI(client.NewClient(server.URL, client.WithHTTPClient(server.Client())))
+		metadata, err := api.GetArtifactVersionMetadata(context.Background(), "test-group", "test-artifact", versionExpression)
+		assert.NoError(t, err)
+		assert.Equal(t, "1.0.0", metadata.Version)
+	})
+
+	t.Run("Strict", func(t *testing.T) {
+		api := apis.NewMetadataAPI(client.NewClient(server.URL, client.WithHTTPClient(server.Client()), client.WithStrictDecoding()))
+		metadata, err := api.GetArtifactVersionMetadata(context.Background(), "test-group", "test-artifact", versionExpression)
+		assert.ErrorContains(t, err, `unknown field "retentionDays"`)
+		assert.Nil(t, metadata)
+	})
+
+	t.Run("Strict With Custom Doer", func(t *testing.T) {
+		// The Doer returns responses without their request, as custom transports may do.
+		doer := requestlessDoer{client: server.Client()}
+		api := apis.NewMetadataAPI(client.NewClient(server.URL, client.WithDoer(doer), client.WithStrictDecoding()))
+		metadata, err := api.GetArtifactVersionMetadata(context.Background(), "test-group", "test-artifact", versionExpression)
+		assert.ErrorContains(t, err, `unknown field "retentionDays"`)
+		assert.Nil(t, metadata)
+	})
+}
+
+type requestlessDoer struct {
+	client *http.Client
+}
+
+func (d requestlessDoer) Do(req *http.Request) (*http.Response, error) {
+	resp, err := d.client.Do(req)
+	if resp != nil {
+		resp.Request = nil
+	}
+	return resp, err
+}
+
 /***** Integration *****/
 /***********************/
 
@@ -0,0 +1,43 @@
+package apis
+
+import "github.com/subzerobo/go-apicurio-sdk/client"
+
+// Registry bundles the APIs of the registry, all sharing one client.
+type Registry struct {
+	Artifacts *ArtifactsAPI
+	Versions  *VersionsAPI
+	Metadata  *MetadataAPI
+	Groups    *GroupsAPI
+	Admin     *AdminAPI
+	System    *SystemAPI
+}
+
+// RegistryOption is a functional option for configuring the Registry.
+type RegistryOption func(*Registry)
+
+// WithDefaultGroup is an option for using groupID whenever a method of the Artifacts, Versions or Metadata API
+// is called with an empty group ID, e.g. in applications that keep all their artifacts in one group.
+// A group ID passed explicitly still takes precedence.
+func WithDefaultGroup(groupID string) RegistryOption {
+	return func(r *Registry) {
+		r.Artifacts.DefaultGroup = groupID
+		r.Versions.DefaultGroup = groupID
+		r.Metadata.DefaultGroup = groupID
+	}
+}
+
+// NewRegistry creates a Registry whose APIs use client.
+func NewRegistry(client *client.Client, opts ...RegistryOption) *Registry {
+	r := &Registry{
+		Artifacts: NewArtifactsAPI(client),
+		Versions:  NewVersionsAPI(client),
+		Metadata:  NewMetadataAPI(client),
+		Groups:    NewGroupsAPI(client),
+		Admin:     NewAdminAPI(client),
+		System:    NewSystemAPI(client),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
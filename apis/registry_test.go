@@ -0,0 +1,75 @@
+package apis_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/apis"
+	"github.com/subzerobo/go-apicurio-sdk/client"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewRegistry_WithDefaultGroup(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Path {
+		case "/groups/com.acme/artifacts/orders", "/groups/other/artifacts/orders":
+			err := json.NewEncoder(w).Encode(models.ArtifactMetadata{})
+			assert.NoError(t, err)
+		default:
+			err := json.NewEncoder(w).Encode(models.ArtifactVersionListResponse{})
+			assert.NoError(t, err)
+		}
+	}))
+	defer server.Close()
+
+	registry := apis.NewRegistry(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()}, apis.WithDefaultGroup("com.acme"))
+
+	t.Run("Default Group", func(t *testing.T) {
+		paths = nil
+		_, err := registry.Metadata.GetArtifactMetadata(context.Background(), "", "orders")
+		assert.NoError(t, err)
+		_, err = registry.Versions.ListArtifactVersions(context.Background(), "", "orders", nil)
+		assert.NoError(t, err)
+		exists, err := registry.Artifacts.ArtifactExists(context.Background(), "", "orders")
+		assert.NoError(t, err)
+		assert.True(t, exists)
+		_, err = registry.Artifacts.CreateArtifact(context.Background(), "", models.CreateArtifactRequest{
+			ArtifactID:   "orders",
+			ArtifactType: models.Json,
+			FirstVersion: models.CreateVersionRequest{Content: models.CreateContentRequest{Content: `{}`}},
+		}, nil)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"/groups/com.acme/artifacts/orders",
+			"/groups/com.acme/artifacts/orders/versions",
+			"/groups/com.acme/artifacts/orders",
+			"/groups/com.acme/artifacts",
+		}, paths)
+	})
+
+	t.Run("Explicit Group", func(t *testing.T) {
+		paths = nil
+		_, err := registry.Metadata.GetArtifactMetadata(context.Background(), "other", "orders")
+		assert.NoError(t, err)
+		_, err = registry.Versions.ListArtifactVersions(context.Background(), "other", "orders", nil)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"/groups/other/artifacts/orders",
+			"/groups/other/artifacts/orders/versions",
+		}, paths)
+	})
+
+	t.Run("No Default Group", func(t *testing.T) {
+		registry := apis.NewRegistry(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		_, err := registry.Metadata.GetArtifactMetadata(context.Background(), "", "orders")
+		assert.ErrorIs(t, err, apis.ErrInvalidInput)
+	})
+}
@@ -0,0 +1,116 @@
+package apis
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"io"
+)
+
+// streamedJSONBody is a request body that embeds content read from a stream as a JSON string,
+// so large content does not have to be held in memory.
+type streamedJSONBody struct {
+	io.Reader
+	pipe *io.PipeReader
+}
+
+// Close stops the goroutine escaping the content if the body was not read to the end.
+func (b *streamedJSONBody) Close() error {
+	return b.pipe.Close()
+}
+
+// newStreamedJSONBody marshals envelope, whose field holding the placeholder string is replaced by the escaped
+// content. The returned length is -1 unless content is an io.Seeker, in which case the content is read twice:
+// once to compute the length and once to send it.
+func newStreamedJSONBody(envelope interface{}, placeholder string, content io.Reader) (*streamedJSONBody, int64, error) {
+	encoded, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to marshal request body as JSON")
+	}
+	quoted, _ := json.Marshal(placeholder)
+	i := bytes.Index(encoded, quoted)
+	if i < 0 {
+		return nil, 0, fmt.Errorf("placeholder %s not found in request body", quoted)
+	}
+	prefix, suffix := encoded[:i+1], encoded[i+len(quoted)-1:]
+
+	length := int64(-1)
+	if seeker, ok := content.(io.Seeker); ok {
+		start, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "failed to seek content")
+		}
+		var counter countingWriter
+		if _, err := io.Copy(jsonStringWriter{&counter}, content); err != nil {
+			return nil, 0, errors.Wrap(err, "failed to read content")
+		}
+		if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+			return nil, 0, errors.Wrap(err, "failed to seek content")
+		}
+		length = int64(len(prefix)) + int64(counter) + int64(len(suffix))
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		buffered := bufio.NewWriterSize(pw, 32<<10)
+		_, err := io.Copy(jsonStringWriter{buffered}, content)
+		if err == nil {
+			err = buffered.Flush()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	return &streamedJSONBody{
+		Reader: io.MultiReader(bytes.NewReader(prefix), pr, bytes.NewReader(suffix)),
+		pipe:   pr,
+	}, length, nil
+}
+
+// jsonStringWriter writes bytes to w escaped for use inside a JSON string literal.
+type jsonStringWriter struct {
+	w io.Writer
+}
+
+func (j jsonStringWriter) Write(p []byte) (int, error) {
+	start := 0
+	for i, b := range p {
+		var escaped string
+		switch {
+		case b == '"':
+			escaped = `\"`
+		case b == '\\':
+			escaped = `\\`
+		case b == '\n':
+			escaped = `\n`
+		case b == '\r':
+			escaped = `\r`
+		case b == '\t':
+			escaped = `\t`
+		case b < 0x20:
+			escaped = fmt.Sprintf(`\u%04x`, b)
+		default:
+			continue
+		}
+		if _, err := j.w.Write(p[start:i]); err != nil {
+			return start, err
+		}
+		if _, err := io.WriteString(j.w, escaped); err != nil {
+			return i, err
+		}
+		start = i + 1
+	}
+	if _, err := j.w.Write(p[start:]); err != nil {
+		return start, err
+	}
+	return len(p), nil
+}
+
+// countingWriter counts the bytes written to it.
+type countingWriter int64
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	*c += countingWriter(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,80 @@
+package apis
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/subzerobo/go-apicurio-sdk/client"
+	"net/http"
+)
+
+type SystemAPI struct {
+	Client *client.Client
+}
+
+func NewSystemAPI(client *client.Client) *SystemAPI {
+	return &SystemAPI{
+		Client: client,
+	}
+}
+
+// Ping checks that the registry is up and serving its API, e.g. for startup checks and readiness probes.
+// It returns ErrRegistryUnavailable when the registry answers with a status other than 200, and the
+// transport error when it cannot be reached before the context is done.
+// GET /system/info
+// See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/System/operation/getSystemInfo
+func (api *SystemAPI) Ping(ctx context.Context) error {
+	url := api.Client.URL("system", "info")
+	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	defer drainAndClose(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Wrapf(ErrRegistryUnavailable, "GET %s: %s", url, resp.Status)
+	}
+	return nil
+}
+
+// executeRequest handles the creation and execution of an HTTP request.
+func (api *SystemAPI) executeRequest(ctx context.Context, method, url string, body interface{}) (*http.Response, error) {
+	var reqBody []byte
+	var err error
+	contentType := "*/*"
+
+	switch v := body.(type) {
+	case string:
+		reqBody = []byte(v)
+		contentType = "*/*"
+	case []byte:
+		reqBody = v
+		contentType = "*/*"
+	default:
+		contentType = "application/json"
+		reqBody, err = json.Marshal(body)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal request body as JSON")
+		}
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create HTTP request")
+	}
+
+	// Set appropriate Content-Type header
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	// Execute the request
+	resp, err := api.Client.Do(req)
+	if err != nil {
+		return nil, requestError(ctx, err)
+	}
+
+	return resp, nil
+}
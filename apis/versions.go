@@ -8,11 +8,18 @@ import (
 	"github.com/pkg/errors"
 	"github.com/subzerobo/go-apicurio-sdk/client"
 	"github.com/subzerobo/go-apicurio-sdk/models"
+	"io"
 	"net/http"
+	"sort"
+	"strconv"
+	"time"
 )
 
 type VersionsAPI struct {
 	Client *client.Client
+
+	// DefaultGroup, when set, is used by the methods taking a group ID when they are called with an empty one.
+	DefaultGroup string
 }
 
 func NewVersionsAPI(client *client.Client) *VersionsAPI {
@@ -28,6 +35,7 @@ func (api *VersionsAPI) DeleteArtifactVersion(
 	ctx context.Context,
 	groupID, artifactID, versionExpression string,
 ) error {
+	groupID = orDefaultGroup(groupID, api.DefaultGroup)
 	// Validate inputs
 	if err := validateInput(groupID, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return err
@@ -35,30 +43,63 @@ func (api *VersionsAPI) DeleteArtifactVersion(
 	if err := validateInput(artifactID, regexGroupIDArtifactID, "Artifact ID"); err != nil {
 		return err
 	}
-	if err := validateInput(versionExpression, regexVersion, "Version Expression"); err != nil {
+	if err := validateVersionExpression(versionExpression); err != nil {
 		return err
 	}
 
 	// Construct the URL
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s/versions/%s", api.Client.BaseURL, groupID, artifactID, versionExpression)
+	url := api.Client.URL("groups", groupID, "artifacts", artifactID, "versions", versionExpression)
 
 	// Execute the request
 	resp, err := api.executeRequest(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
 
-	return handleResponse(resp, http.StatusNoContent, nil)
+	if resp.StatusCode == http.StatusMethodNotAllowed {
+		apiError, parseErr := parseAPIError(resp)
+		drainAndClose(resp.Body)
+		if parseErr != nil {
+			return ErrVersionDeletionDisabled
+		}
+		return errors.Wrap(ErrVersionDeletionDisabled, apiError.Error())
+	}
+
+	return handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding)
+}
+
+// DeleteArtifactVersions deletes several versions of the artifact, issuing a bounded number of requests concurrently.
+// It returns the versions that were deleted, in input order, and the error for each version that was not.
+// Versions that were not attempted because ctx was cancelled are reported with the context error.
+func (api *VersionsAPI) DeleteArtifactVersions(
+	ctx context.Context,
+	groupID, artifactID string,
+	versions []string,
+) (deleted []string, failed map[string]error) {
+	errs := forEachBounded(ctx, len(versions), bulkConcurrency, func(ctx context.Context, i int) error {
+		return api.DeleteArtifactVersion(ctx, groupID, artifactID, versions[i])
+	})
+
+	failed = make(map[string]error)
+	for i, err := range errs {
+		if err != nil {
+			failed[versions[i]] = err
+			continue
+		}
+		deleted = append(deleted, versions[i])
+	}
+
+	return deleted, failed
 }
 
 // GetArtifactVersionReferences retrieves all references for a single artifact version.
+// When params or params.RefType is empty, the server returns the OUTBOUND references, i.e. the artifacts
+// this version refers to. See InboundReferences and OutboundReferences.
 func (api *VersionsAPI) GetArtifactVersionReferences(ctx context.Context,
 	groupId, artifactId, versionExpression string,
 	params *models.ArtifactVersionReferencesParams,
 ) (*[]models.ArtifactReference, error) {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
 	// Validate inputs
 	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return nil, err
@@ -66,7 +107,7 @@ func (api *VersionsAPI) GetArtifactVersionReferences(ctx context.Context,
 	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
 		return nil, err
 	}
-	if err := validateInput(versionExpression, regexVersion, "Version Expression"); err != nil {
+	if err := validateVersionExpression(versionExpression); err != nil {
 		return nil, err
 	}
 
@@ -76,14 +117,7 @@ func (api *VersionsAPI) GetArtifactVersionReferences(ctx context.Context,
 	}
 
 	// Start building the URL
-	url := fmt.Sprintf(
-		"%s/groups/%s/artifacts/%s/versions/%s/references%s",
-		api.Client.BaseURL,
-		groupId,
-		artifactId,
-		versionExpression,
-		query,
-	)
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId, "versions", versionExpression, "references") + query
 
 	// Execute the request
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
@@ -92,18 +126,111 @@ func (api *VersionsAPI) GetArtifactVersionReferences(ctx context.Context,
 	}
 
 	var references []models.ArtifactReference
-	if err = handleResponse(resp, http.StatusOK, &references); err != nil {
+	if err = handleResponse(resp, http.StatusOK, &references, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
 
 	return &references, nil
 }
 
+// InboundReferences retrieves the references other artifacts hold to a single artifact version.
+func (api *VersionsAPI) InboundReferences(ctx context.Context, groupId, artifactId, versionExpression string) (*[]models.ArtifactReference, error) {
+	return api.GetArtifactVersionReferences(ctx, groupId, artifactId, versionExpression, &models.ArtifactVersionReferencesParams{RefType: models.InBound})
+}
+
+// OutboundReferences retrieves the references a single artifact version holds to other artifacts.
+func (api *VersionsAPI) OutboundReferences(ctx context.Context, groupId, artifactId, versionExpression string) (*[]models.ArtifactReference, error) {
+	return api.GetArtifactVersionReferences(ctx, groupId, artifactId, versionExpression, &models.ArtifactVersionReferencesParams{RefType: models.OutBound})
+}
+
+// ListVersionBranches lists the IDs of the branches that include a version of the artifact.
+// The registry has no direct lookup, so every branch of the artifact is searched for the version.
+// An unknown version is reported by the server as a 404.
+func (api *VersionsAPI) ListVersionBranches(ctx context.Context, groupId, artifactId, versionExpression string) ([]string, error) {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
+	metadata, err := NewMetadataAPI(api.Client).GetArtifactVersionMetadata(ctx, groupId, artifactId, versionExpression)
+	if err != nil {
+		return nil, err
+	}
+
+	branches, err := api.listArtifactBranches(ctx, groupId, artifactId)
+	if err != nil {
+		return nil, err
+	}
+
+	branchIDs := []string{}
+	for _, branch := range branches {
+		versions, err := api.listBranchVersions(ctx, groupId, artifactId, branch.BranchID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list versions of branch %s", branch.BranchID)
+		}
+		for _, version := range versions {
+			if version.Version == metadata.Version {
+				branchIDs = append(branchIDs, branch.BranchID)
+				break
+			}
+		}
+	}
+
+	return branchIDs, nil
+}
+
+// listArtifactBranches fetches every page of the branches of an artifact.
+func (api *VersionsAPI) listArtifactBranches(ctx context.Context, groupId, artifactId string) ([]models.Branch, error) {
+	var branches []models.Branch
+	for {
+		url := api.Client.URL("groups", groupId, "artifacts", artifactId, "branches") +
+			fmt.Sprintf("?offset=%d&limit=%d", len(branches), listPageSize)
+
+		resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page models.BranchListResponse
+		if err := handleResponse(resp, http.StatusOK, &page, api.Client.StrictDecoding); err != nil {
+			return nil, err
+		}
+
+		branches = append(branches, page.Branches...)
+		if len(page.Branches) == 0 || len(branches) >= page.Count {
+			return branches, nil
+		}
+	}
+}
+
+// listBranchVersions fetches every page of the versions on a branch.
+func (api *VersionsAPI) listBranchVersions(ctx context.Context, groupId, artifactId, branchId string) ([]models.ArtifactVersion, error) {
+	var versions []models.ArtifactVersion
+	for {
+		url := api.Client.URL("groups", groupId, "artifacts", artifactId, "branches", branchId, "versions") +
+			fmt.Sprintf("?offset=%d&limit=%d", len(versions), listPageSize)
+
+		resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page models.ArtifactVersionListResponse
+		if err := handleResponse(resp, http.StatusOK, &page, api.Client.StrictDecoding); err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, page.Versions...)
+		if len(page.Versions) == 0 || len(versions) >= page.Count {
+			return versions, nil
+		}
+	}
+}
+
 // GetArtifactVersionComments retrieves all comments for a version of an artifact.
+// When params is set, comments are sorted by creation time and truncated to params.Limit.
 func (api *VersionsAPI) GetArtifactVersionComments(
 	ctx context.Context,
 	groupId, artifactId, versionExpression string,
+	params *models.CommentListParams,
 ) (*[]models.ArtifactComment, error) {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
 	// Validate inputs
 	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return nil, err
@@ -111,12 +238,17 @@ func (api *VersionsAPI) GetArtifactVersionComments(
 	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
 		return nil, err
 	}
-	if err := validateInput(versionExpression, regexVersion, "Version Expression"); err != nil {
+	if err := validateVersionExpression(versionExpression); err != nil {
 		return nil, err
 	}
+	if params != nil {
+		if err := validateParams(params); err != nil {
+			return nil, err
+		}
+	}
 
 	// Construct the URL
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s/versions/%s/comments", api.Client.BaseURL, groupId, artifactId, versionExpression)
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId, "versions", versionExpression, "comments")
 
 	// Execute the request
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
@@ -126,19 +258,45 @@ func (api *VersionsAPI) GetArtifactVersionComments(
 
 	// Parse the response
 	var comments []models.ArtifactComment
-	if err = handleResponse(resp, http.StatusOK, &comments); err != nil {
+	if err = handleResponse(resp, http.StatusOK, &comments, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
 
+	if params != nil {
+		sortComments(comments, params.Order)
+		if params.Limit > 0 && len(comments) > params.Limit {
+			comments = comments[:params.Limit]
+		}
+	}
+
 	return &comments, nil
 }
 
+// sortComments orders comments by creation time, falling back to the comment ID for equal timestamps.
+func sortComments(comments []models.ArtifactComment, order models.Order) {
+	createdOn := func(c models.ArtifactComment) time.Time {
+		t, _ := c.CreatedTime()
+		return t
+	}
+	sort.SliceStable(comments, func(i, j int) bool {
+		a, b := comments[i], comments[j]
+		if order == models.OrderDesc {
+			a, b = b, a
+		}
+		if ta, tb := createdOn(a), createdOn(b); !ta.Equal(tb) {
+			return ta.Before(tb)
+		}
+		return a.CommentID < b.CommentID
+	})
+}
+
 // AddArtifactVersionComment adds a new comment to a specific artifact version.
 func (api *VersionsAPI) AddArtifactVersionComment(
 	ctx context.Context,
 	groupId, artifactId, versionExpression string,
 	commentValue string,
 ) (*models.ArtifactComment, error) {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
 	// Validate inputs
 	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return nil, err
@@ -146,18 +304,12 @@ func (api *VersionsAPI) AddArtifactVersionComment(
 	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
 		return nil, err
 	}
-	if err := validateInput(versionExpression, regexVersion, "Version Expression"); err != nil {
+	if err := validateVersionExpression(versionExpression); err != nil {
 		return nil, err
 	}
 
 	// Construct the URL
-	url := fmt.Sprintf(
-		"%s/groups/%s/artifacts/%s/versions/%s/comments",
-		api.Client.BaseURL,
-		groupId,
-		artifactId,
-		versionExpression,
-	)
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId, "versions", versionExpression, "comments")
 
 	// Create the request body
 	requestBody := map[string]string{
@@ -172,37 +324,62 @@ func (api *VersionsAPI) AddArtifactVersionComment(
 
 	// Handle the response
 	var comment models.ArtifactComment
-	if err := handleResponse(resp, http.StatusOK, &comment); err != nil {
+	if err := handleResponse(resp, http.StatusOK, &comment, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
 
 	return &comment, nil
 }
 
+// AddCommentAndList adds a comment to an artifact version and returns all of its comments, newest first.
+// The created comment is always first, even when another comment has the same creation time.
+func (api *VersionsAPI) AddCommentAndList(
+	ctx context.Context,
+	groupId, artifactId, versionExpression string,
+	commentValue string,
+) (*[]models.ArtifactComment, error) {
+	created, err := api.AddArtifactVersionComment(ctx, groupId, artifactId, versionExpression, commentValue)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := api.GetArtifactVersionComments(ctx, groupId, artifactId, versionExpression, &models.CommentListParams{Order: models.OrderDesc})
+	if err != nil {
+		return nil, errors.Wrapf(err, "comment %s was added but listing comments failed", created.CommentID)
+	}
+
+	list := *comments
+	for i, comment := range list {
+		if comment.CommentID == created.CommentID {
+			copy(list[1:i+1], list[:i])
+			list[0] = comment
+			break
+		}
+	}
+	return &list, nil
+}
+
 // UpdateArtifactVersionComment updates the value of a single comment in an artifact version.
 func (api *VersionsAPI) UpdateArtifactVersionComment(
 	ctx context.Context,
 	groupId, artifactId, versionExpression, commentId string,
 	updatedComment string,
 ) error {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
 	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return err
 	}
 	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
 		return err
 	}
-	if err := validateInput(versionExpression, regexVersion, "Version Expression"); err != nil {
+	if err := validateVersionExpression(versionExpression); err != nil {
+		return err
+	}
+	if err := validateCommentID(commentId); err != nil {
 		return err
 	}
 	// Build the URL
-	url := fmt.Sprintf(
-		"%s/groups/%s/artifacts/%s/versions/%s/comments/%s",
-		api.Client.BaseURL,
-		groupId,
-		artifactId,
-		versionExpression,
-		commentId,
-	)
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId, "versions", versionExpression, "comments", commentId)
 
 	// Create the request body
 	requestBody := map[string]string{
@@ -216,7 +393,7 @@ func (api *VersionsAPI) UpdateArtifactVersionComment(
 	}
 
 	// Handle the response
-	if err := handleResponse(resp, http.StatusNoContent, nil); err != nil {
+	if err := handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding); err != nil {
 		return err
 	}
 
@@ -228,40 +405,73 @@ func (api *VersionsAPI) DeleteArtifactVersionComment(
 	ctx context.Context,
 	groupId, artifactId, versionExpression, commentId string,
 ) error {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
 	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return err
 	}
 	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
 		return err
 	}
-	if err := validateInput(versionExpression, regexVersion, "Version Expression"); err != nil {
+	if err := validateVersionExpression(versionExpression); err != nil {
+		return err
+	}
+	if err := validateCommentID(commentId); err != nil {
 		return err
 	}
 
-	url := fmt.Sprintf(
-		"%s/groups/%s/artifacts/%s/versions/%s/comments/%s",
-		api.Client.BaseURL,
-		groupId,
-		artifactId,
-		versionExpression,
-		commentId,
-	)
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId, "versions", versionExpression, "comments", commentId)
 
 	resp, err := api.executeRequest(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return err
 	}
 
-	return handleResponse(resp, http.StatusNoContent, nil)
+	return handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding)
 
 }
 
+// DeleteAllArtifactVersionComments deletes every comment of an artifact version, e.g. to clean up after tests,
+// with at most a few deletions in flight. A comment that cannot be deleted, e.g. because it is owned by another
+// user or was already deleted, does not stop the others; the failures are returned together, one per comment.
+func (api *VersionsAPI) DeleteAllArtifactVersionComments(
+	ctx context.Context,
+	groupId, artifactId, versionExpression string,
+) error {
+	comments, err := api.GetArtifactVersionComments(ctx, groupId, artifactId, versionExpression, nil)
+	if err != nil {
+		return err
+	}
+
+	list := *comments
+	errs := forEachBounded(ctx, len(list), bulkConcurrency, func(ctx context.Context, i int) error {
+		if err := api.DeleteArtifactVersionComment(ctx, groupId, artifactId, versionExpression, list[i].CommentID); err != nil {
+			return errors.Wrapf(err, "failed to delete comment %s", list[i].CommentID)
+		}
+		return nil
+	})
+	return joinErrors(errs)
+}
+
 // ListArtifactVersions retrieves all versions of an artifact.
 func (api *VersionsAPI) ListArtifactVersions(
 	ctx context.Context,
 	groupId, artifactId string,
-	params *models.ListArtifactsInGroupParams,
+	params *models.ListVersionsParams,
 ) (*[]models.ArtifactVersion, error) {
+	versionsResponse, err := api.listArtifactVersionsPage(ctx, groupId, artifactId, params)
+	if err != nil {
+		return nil, err
+	}
+	return &versionsResponse.Versions, nil
+}
+
+// listArtifactVersionsPage fetches a single page of the versions of an artifact together with the total count.
+func (api *VersionsAPI) listArtifactVersionsPage(
+	ctx context.Context,
+	groupId, artifactId string,
+	params *models.ListVersionsParams,
+) (*models.ArtifactVersionListResponse, error) {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
 	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return nil, err
 	}
@@ -271,9 +481,12 @@ func (api *VersionsAPI) ListArtifactVersions(
 
 	query := ""
 	if params != nil {
+		if err := validateParams(params); err != nil {
+			return nil, err
+		}
 		query = "?" + params.ToQuery().Encode()
 	}
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s/versions%s", api.Client.BaseURL, groupId, artifactId, query)
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId, "versions") + query
 
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
@@ -281,70 +494,289 @@ func (api *VersionsAPI) ListArtifactVersions(
 	}
 
 	var versionsResponse = models.ArtifactVersionListResponse{}
-	if err = handleResponse(resp, http.StatusOK, &versionsResponse); err != nil {
+	if err = handleResponse(resp, http.StatusOK, &versionsResponse, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
 
-	return &versionsResponse.Versions, nil
+	return &versionsResponse, nil
+}
+
+// ListArtifactVersionsFiltered retrieves all versions of an artifact that are in one of the given states,
+// e.g. to leave out DISABLED versions. The registry cannot filter the list by state, so every page of
+// versions is fetched and filtered client-side. Without states, all versions are returned.
+func (api *VersionsAPI) ListArtifactVersionsFiltered(
+	ctx context.Context,
+	groupId, artifactId string,
+	states ...models.State,
+) (*[]models.ArtifactVersion, error) {
+	wanted := make(map[models.State]bool, len(states))
+	for _, state := range states {
+		if !state.IsValid() {
+			return nil, errors.Wrapf(ErrInvalidArgument, "State: unknown state %q", state)
+		}
+		wanted[state] = true
+	}
+
+	versions := []models.ArtifactVersion{}
+	for offset := 0; ; {
+		page, err := api.listArtifactVersionsPage(ctx, groupId, artifactId, &models.ListVersionsParams{Offset: offset, Limit: listPageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, version := range page.Versions {
+			if len(wanted) == 0 || wanted[version.State] {
+				versions = append(versions, version)
+			}
+		}
+		offset += len(page.Versions)
+		if len(page.Versions) == 0 || offset >= page.Count {
+			return &versions, nil
+		}
+	}
+}
+
+// ForEachArtifactVersion calls fn for every version of an artifact, fetching one page at a time instead of
+// holding all versions in memory. params.Order and params.OrderBy set the order of the calls, params.Offset
+// the first version, and params.Limit the page size. Iteration stops at the first error returned by fn,
+// which is returned as is, or when ctx is done.
+func (api *VersionsAPI) ForEachArtifactVersion(
+	ctx context.Context,
+	groupId, artifactId string,
+	params *models.ListVersionsParams,
+	fn func(models.ArtifactVersion) error,
+) error {
+	pageParams := models.ListVersionsParams{}
+	if params != nil {
+		pageParams = *params
+	}
+	if pageParams.Limit == 0 {
+		pageParams.Limit = listPageSize
+	}
+
+	for {
+		page, err := api.ListArtifactVersions(ctx, groupId, artifactId, &pageParams)
+		if err != nil {
+			return err
+		}
+		for _, version := range *page {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(version); err != nil {
+				return err
+			}
+		}
+		if len(*page) < pageParams.Limit {
+			return nil
+		}
+		pageParams.Offset += pageParams.Limit
+	}
+}
 
+// ListArtifactVersionsLegacy retrieves all versions of an artifact using the ListArtifactsInGroupParams.
+//
+// Deprecated: Use ListArtifactVersions with models.ListVersionsParams instead.
+func (api *VersionsAPI) ListArtifactVersionsLegacy(
+	ctx context.Context,
+	groupId, artifactId string,
+	params *models.ListArtifactsInGroupParams,
+) (*[]models.ArtifactVersion, error) {
+	var versionParams *models.ListVersionsParams
+	if params != nil {
+		versionParams = &models.ListVersionsParams{
+			Limit:   params.Limit,
+			Offset:  params.Offset,
+			Order:   params.Order,
+			OrderBy: params.OrderBy,
+		}
+	}
+	return api.ListArtifactVersions(ctx, groupId, artifactId, versionParams)
 }
 
 // CreateArtifactVersion creates a new version of the artifact.
+// A dry run persists nothing, so the returned version has no GlobalID or ContentID. Identifiers missing
+// from the response body are taken from the Location header when the server sends one.
+// A non-empty request.ArtifactType is sent in the X-Registry-ArtifactType header.
 func (api *VersionsAPI) CreateArtifactVersion(
 	ctx context.Context,
 	groupId, artifactId string,
 	request *models.CreateVersionRequest,
 	dryRun bool,
 ) (*models.ArtifactVersionDetailed, error) {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
 	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return nil, err
 	}
 	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
 		return nil, err
 	}
+	if request != nil {
+		if request.ArtifactType != "" {
+			if err := validateArtifactType(api.Client, request.ArtifactType); err != nil {
+				return nil, err
+			}
+		}
+		if err := validateJSONSchemaContent(api.Client, request.ArtifactType, request.Content.Content); err != nil {
+			return nil, err
+		}
+		if request.Content.ContentType == "" {
+			withContentType := *request
+			withContentType.Content.ContentType = models.DetectContentType(request.ArtifactType, request.Content.Content)
+			request = &withContentType
+		}
+	}
 
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s/versions", api.Client.BaseURL, groupId, artifactId)
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId, "versions")
 	if dryRun {
 		url = fmt.Sprintf("%s?dryRun=true", url)
 	}
 
-	resp, err := api.executeRequest(ctx, http.MethodPost, url, request)
+	var artifactType string
+	if request != nil {
+		artifactType = string(request.ArtifactType)
+	}
+	resp, err := api.executeRequest(ctx, http.MethodPost, url, request,
+		withHeader(headerIdempotencyKey, idempotencyKey(ctx)),
+		withHeader(headerArtifactType, artifactType),
+		withPreservedIDs(ctx),
+	)
 	if err != nil {
 		return nil, err
 	}
 
 	var version models.ArtifactVersionDetailed
-	if err = handleResponse(resp, http.StatusOK, &version); err != nil {
+	if err = handleResponse(resp, http.StatusOK, &version, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
+	if version.GlobalID == 0 {
+		applyVersionLocation(&version, resp.Header.Get("Location"))
+	}
 
 	return &version, nil
 
 }
 
+// CreateArtifactVersionFromReader creates a new version of the artifact like CreateArtifactVersion, streaming its
+// content from content instead of request.Content.Content, which must be empty. This keeps very large artifacts
+// out of memory. The Content-Length is set when content is an io.Seeker (e.g. an *os.File); otherwise the body
+// is sent chunked. The local JSON Schema validation of client.WithJSONSchemaValidation is not applied.
+func (api *VersionsAPI) CreateArtifactVersionFromReader(
+	ctx context.Context,
+	groupId, artifactId string,
+	request *models.CreateVersionRequest,
+	content io.Reader,
+	dryRun bool,
+) (*models.ArtifactVersionDetailed, error) {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
+	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
+		return nil, err
+	}
+	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
+		return nil, err
+	}
+	if request == nil || request.Content.Content != "" {
+		return nil, errors.Wrap(ErrInvalidArgument, "request is required and its content must be empty when streaming content")
+	}
+	if request.ArtifactType != "" {
+		if err := validateArtifactType(api.Client, request.ArtifactType); err != nil {
+			return nil, err
+		}
+	}
+
+	const placeholder = "\x00streamed-content\x00"
+	envelope := *request
+	envelope.Content.Content = placeholder
+	if envelope.Content.ContentType == "" {
+		envelope.Content.ContentType = models.DetectContentType(request.ArtifactType, "")
+	}
+	body, length, err := newStreamedJSONBody(envelope, placeholder, content)
+	if err != nil {
+		return nil, err
+	}
+
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId, "versions")
+	if dryRun {
+		url = fmt.Sprintf("%s?dryRun=true", url)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		_ = body.Close()
+		return nil, errors.Wrap(err, "failed to create HTTP request")
+	}
+	req.ContentLength = length
+	withHeader(headerIdempotencyKey, idempotencyKey(ctx))(req)
+	withHeader(headerArtifactType, string(request.ArtifactType))(req)
+	withPreservedIDs(ctx)(req)
+	req.Header.Set("Content-Type", ContentTypeJSON)
+
+	resp, err := api.Client.Do(req)
+	if err != nil {
+		// A custom Doer may fail without closing the body, which would leave the streaming goroutine blocked.
+		_ = body.Close()
+		return nil, requestError(ctx, err)
+	}
+
+	var version models.ArtifactVersionDetailed
+	if err = handleResponse(resp, http.StatusOK, &version, api.Client.StrictDecoding); err != nil {
+		return nil, err
+	}
+	if version.GlobalID == 0 {
+		applyVersionLocation(&version, resp.Header.Get("Location"))
+	}
+
+	return &version, nil
+}
+
+// CheckCompatibility asks the server whether content could be added as a new version of the artifact under
+// its rules, e.g. COMPATIBILITY against the latest version, without creating it. Content rejected by a rule
+// (409) is reported as a result that is not compatible, together with the violations the server gave; other
+// failures are returned as errors.
+func (api *VersionsAPI) CheckCompatibility(
+	ctx context.Context,
+	groupId, artifactId string,
+	content models.CreateContentRequest,
+) (*models.CompatibilityResult, error) {
+	_, err := api.CreateArtifactVersion(ctx, groupId, artifactId, &models.CreateVersionRequest{Content: content}, true)
+	if err == nil {
+		return &models.CompatibilityResult{Compatible: true}, nil
+	}
+
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) || apiErr.Status != http.StatusConflict {
+		return nil, err
+	}
+
+	return &models.CompatibilityResult{Compatible: false, Reasons: ruleViolationReasons(apiErr)}, nil
+}
+
 // GetArtifactVersionContent retrieves a single version of the artifact.
+// Set params.Accept to request a specific representation; the content type the server used is returned in ContentType.
 func (api *VersionsAPI) GetArtifactVersionContent(
 	ctx context.Context,
 	groupId, artifactId, versionExpression string,
 	params *models.ArtifactReferenceParams,
 ) (*models.ArtifactContent, error) {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
 	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return nil, err
 	}
 	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
 		return nil, err
 	}
-	if err := validateInput(versionExpression, regexVersion, "Version Expression"); err != nil {
+	if err := validateVersionExpression(versionExpression); err != nil {
 		return nil, err
 	}
 
 	query := ""
+	accept := ""
 	if params != nil {
 		query = "?" + params.ToQuery().Encode()
+		accept = params.Accept
 	}
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s/versions/%s/content%s", api.Client.BaseURL, groupId, artifactId, versionExpression, query)
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId, "versions", versionExpression, "content") + query
 
-	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
+	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil, withHeader("Accept", accept))
 	if err != nil {
 		return nil, err
 	}
@@ -355,34 +787,128 @@ func (api *VersionsAPI) GetArtifactVersionContent(
 	}
 
 	return &models.ArtifactContent{
-		Content: content,
+		Content:     content,
+		ContentType: resp.Header.Get("Content-Type"),
 	}, nil
 }
 
+// GetArtifactVersionContentWithRefsResolved retrieves the content of a version with the artifacts it references
+// inlined by the server (references=DEREFERENCE), e.g. a self-contained Avro or JSON schema without $refs.
+// The content grows with every referenced schema, so prefer GetArtifactVersionContent and
+// GetArtifactVersionReferences when the references are shared by many versions.
+func (api *VersionsAPI) GetArtifactVersionContentWithRefsResolved(
+	ctx context.Context,
+	groupId, artifactId, versionExpression string,
+) (*models.ArtifactContent, error) {
+	params := &models.ArtifactReferenceParams{HandleReferencesType: models.HandleReferencesTypeDereference}
+	return api.GetArtifactVersionContent(ctx, groupId, artifactId, versionExpression, params)
+}
+
+// DiffVersions compares the fields of two versions of a JSON or AVRO artifact, e.g. to review a schema change.
+// The registry has no diff endpoint, so both contents are fetched and compared with models.DiffSchemas.
+// Other artifact types return models.ErrDiffUnsupported.
+func (api *VersionsAPI) DiffVersions(ctx context.Context, groupId, artifactId, fromVersion, toVersion string) (*models.SchemaDiff, error) {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
+	if err := validateVersionExpression(fromVersion); err != nil {
+		return nil, err
+	}
+	if err := validateVersionExpression(toVersion); err != nil {
+		return nil, err
+	}
+
+	metadata, err := NewMetadataAPI(api.Client).GetArtifactMetadata(ctx, groupId, artifactId)
+	if err != nil {
+		return nil, err
+	}
+	from, err := api.GetArtifactVersionContent(ctx, groupId, artifactId, fromVersion, nil)
+	if err != nil {
+		return nil, err
+	}
+	to, err := api.GetArtifactVersionContent(ctx, groupId, artifactId, toVersion, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := models.DiffSchemas(models.ArtifactType(metadata.ArtifactType), []byte(from.Content), []byte(to.Content))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to diff versions %s and %s", fromVersion, toVersion)
+	}
+	return diff, nil
+}
+
 // UpdateArtifactVersionContent updates the content of a single version of the artifact.
+// The references of content replace those of the version, so they must be given again for the INTEGRITY rule
+// to resolve them. Empty content with references is rejected without contacting the registry.
+// When content has no content type, the artifact's type is fetched to infer it; if the artifact has no type
+// the content type is left unset for the server to decide.
 func (api *VersionsAPI) UpdateArtifactVersionContent(
 	ctx context.Context,
 	groupId, artifactId, versionExpression string,
 	content *models.CreateContentRequest,
 ) error {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
 	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return err
 	}
 	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
 		return err
 	}
-	if err := validateInput(versionExpression, regexVersion, "Version Expression"); err != nil {
+	if err := validateVersionExpression(versionExpression); err != nil {
 		return err
 	}
+	if content != nil && content.Content == "" && len(content.References) > 0 {
+		return errors.Wrap(ErrInvalidArgument, "Content: references require content")
+	}
+
+	if content != nil && content.ContentType == "" {
+		metadata, err := NewMetadataAPI(api.Client).GetArtifactMetadata(ctx, groupId, artifactId)
+		if err != nil {
+			return errors.Wrap(err, "failed to fetch artifact type")
+		}
+		if metadata.ArtifactType != "" {
+			withContentType := *content
+			withContentType.ContentType = models.DetectContentType(models.ArtifactType(metadata.ArtifactType), content.Content)
+			content = &withContentType
+		}
+	}
 
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s/versions/%s/content", api.Client.BaseURL, groupId, artifactId, versionExpression)
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId, "versions", versionExpression, "content")
 
 	resp, err := api.executeRequest(ctx, http.MethodPut, url, content)
 	if err != nil {
 		return err
 	}
 
-	return handleResponse(resp, http.StatusNoContent, nil)
+	return handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding)
+}
+
+// UpdateArtifactVersionContentType changes the stored content type of a version without changing its content.
+// The registry has no dedicated endpoint for this, so the current content and outbound references are
+// fetched and written back with the new content type through UpdateArtifactVersionContent.
+func (api *VersionsAPI) UpdateArtifactVersionContentType(
+	ctx context.Context,
+	groupId, artifactId, versionExpression, contentType string,
+) error {
+	if contentType == "" {
+		return errors.Wrap(ErrInvalidArgument, "Content Type: must not be empty")
+	}
+
+	content, err := api.GetArtifactVersionContent(ctx, groupId, artifactId, versionExpression, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch current content")
+	}
+
+	references, err := api.GetArtifactVersionReferences(ctx, groupId, artifactId, versionExpression,
+		&models.ArtifactVersionReferencesParams{RefType: models.OutBound})
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch current references")
+	}
+
+	return api.UpdateArtifactVersionContent(ctx, groupId, artifactId, versionExpression, &models.CreateContentRequest{
+		Content:     content.Content,
+		References:  *references,
+		ContentType: contentType,
+	})
 }
 
 // SearchForArtifactVersions searches for versions of an artifact.
@@ -393,10 +919,13 @@ func (api *VersionsAPI) SearchForArtifactVersions(
 
 	query := ""
 	if params != nil {
+		if err := validateParams(params); err != nil {
+			return nil, err
+		}
 		query = params.ToQuery().Encode()
 	}
 
-	url := fmt.Sprintf("%s/search/versions?%s", api.Client.BaseURL, query)
+	url := api.Client.URL("search", "versions") + "?" + query
 
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
@@ -404,7 +933,7 @@ func (api *VersionsAPI) SearchForArtifactVersions(
 	}
 
 	var searchVersionsResponse = models.ArtifactVersionListResponse{}
-	if err = handleResponse(resp, http.StatusOK, &searchVersionsResponse); err != nil {
+	if err = handleResponse(resp, http.StatusOK, &searchVersionsResponse, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
 
@@ -412,25 +941,40 @@ func (api *VersionsAPI) SearchForArtifactVersions(
 }
 
 // SearchForArtifactVersionByContent searches for a version of an artifact by content.
+// The content is posted with the given contentType (e.g. application/json for Avro, application/x-protobuf
+// for Protobuf) so the server can parse and canonicalize it; an empty contentType sends */*.
 func (api *VersionsAPI) SearchForArtifactVersionByContent(
 	ctx context.Context,
 	content string,
+	contentType string,
 	params *models.SearchVersionByContentParams,
 ) (*[]models.ArtifactVersion, error) {
+	if contentType == "" {
+		contentType = "*/*"
+	}
+
 	query := ""
 	if params != nil {
 		query = params.ToQuery().Encode()
+
+		if params.CanonicalizeLocally {
+			canonical, err := models.Canonicalize(params.ArtifactType, []byte(content))
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to canonicalize content")
+			}
+			content = string(canonical)
+		}
 	}
 
-	url := fmt.Sprintf("%s/search/versions?%s", api.Client.BaseURL, query)
+	url := api.Client.URL("search", "versions") + "?" + query
 
-	resp, err := api.executeRequest(ctx, http.MethodPost, url, content)
+	resp, err := api.executeRequest(ctx, http.MethodPost, url, content, withHeader("Content-Type", contentType))
 	if err != nil {
 		return nil, err
 	}
 
 	var searchVersionsResponse = models.ArtifactVersionListResponse{}
-	if err = handleResponse(resp, http.StatusOK, &searchVersionsResponse); err != nil {
+	if err = handleResponse(resp, http.StatusOK, &searchVersionsResponse, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
 
@@ -438,10 +982,12 @@ func (api *VersionsAPI) SearchForArtifactVersionByContent(
 }
 
 // GetArtifactVersionState retrieves the current state of an artifact version.
+// A state unknown to the SDK is returned as is, unless client.WithStrictStates is set.
 func (api *VersionsAPI) GetArtifactVersionState(
 	ctx context.Context,
 	groupId, artifactId, versionExpression string,
 ) (*models.State, error) {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
 	// Validate inputs
 	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return nil, err
@@ -449,12 +995,12 @@ func (api *VersionsAPI) GetArtifactVersionState(
 	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
 		return nil, err
 	}
-	if err := validateInput(versionExpression, regexVersion, "Version Expression"); err != nil {
+	if err := validateVersionExpression(versionExpression); err != nil {
 		return nil, err
 	}
 
 	// Build the URL
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s/versions/%s/state", api.Client.BaseURL, groupId, artifactId, versionExpression)
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId, "versions", versionExpression, "state")
 
 	// Execute the request
 	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
@@ -464,20 +1010,27 @@ func (api *VersionsAPI) GetArtifactVersionState(
 
 	// Parse response
 	var stateResponse models.StateResponse
-	if err = handleResponse(resp, http.StatusOK, &stateResponse); err != nil {
+	if err = handleResponse(resp, http.StatusOK, &stateResponse, api.Client.StrictDecoding); err != nil {
 		return nil, err
 	}
+	if api.Client.StrictStates {
+		if _, err := models.ParseState(string(stateResponse.State)); err != nil {
+			return nil, err
+		}
+	}
 
 	return &stateResponse.State, nil
 }
 
 // UpdateArtifactVersionState updates the state of an artifact version.
+// Unknown states are rejected locally; see client.WithStateTransitionValidation for checking the transition as well.
 func (api *VersionsAPI) UpdateArtifactVersionState(
 	ctx context.Context,
 	groupId, artifactId, versionExpression string,
 	state models.State,
 	dryRun bool,
 ) error {
+	groupId = orDefaultGroup(groupId, api.DefaultGroup)
 	// Validate inputs
 	if err := validateInput(groupId, regexGroupIDArtifactID, "Group ID"); err != nil {
 		return err
@@ -485,12 +1038,24 @@ func (api *VersionsAPI) UpdateArtifactVersionState(
 	if err := validateInput(artifactId, regexGroupIDArtifactID, "Artifact ID"); err != nil {
 		return err
 	}
-	if err := validateInput(versionExpression, regexVersion, "Version Expression"); err != nil {
+	if err := validateVersionExpression(versionExpression); err != nil {
 		return err
 	}
+	if !state.IsValid() {
+		return errors.Wrapf(ErrInvalidArgument, "State: unknown state %q", state)
+	}
+	if api.Client.ValidateStateTransitions {
+		current, err := api.GetArtifactVersionState(ctx, groupId, artifactId, versionExpression)
+		if err != nil {
+			return errors.Wrap(err, "failed to fetch current state")
+		}
+		if !current.CanTransitionTo(state) {
+			return errors.Wrapf(ErrInvalidArgument, "illegal state transition: %s -> %s", *current, state)
+		}
+	}
 
 	// Construct the URL with optional dryRun parameter
-	url := fmt.Sprintf("%s/groups/%s/artifacts/%s/versions/%s/state", api.Client.BaseURL, groupId, artifactId, versionExpression)
+	url := api.Client.URL("groups", groupId, "artifacts", artifactId, "versions", versionExpression, "state")
 	if dryRun {
 		url += "?dryRun=true"
 	}
@@ -507,15 +1072,114 @@ func (api *VersionsAPI) UpdateArtifactVersionState(
 	}
 
 	// Handle response
-	if err = handleResponse(resp, http.StatusNoContent, nil); err != nil {
+	if err = handleResponse(resp, http.StatusNoContent, nil, api.Client.StrictDecoding); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// ClearArtifactVersionState reverts an artifact version to the state versions have by default.
+// The registry has no endpoint for clearing an explicit state, so the version is set to ENABLED,
+// the state new non-draft versions are created in.
+func (api *VersionsAPI) ClearArtifactVersionState(ctx context.Context, groupId, artifactId, versionExpression string) error {
+	return api.UpdateArtifactVersionState(ctx, groupId, artifactId, versionExpression, models.StateEnabled, false)
+}
+
+// FinalizeDraft publishes a draft version by moving it from DRAFT to ENABLED, e.g. once a version created with
+// IsDraft has been reviewed. A version that is not a draft fails with an error wrapping ErrInvalidArgument and is
+// left unchanged.
+func (api *VersionsAPI) FinalizeDraft(ctx context.Context, groupId, artifactId, versionExpression string) error {
+	current, err := api.GetArtifactVersionState(ctx, groupId, artifactId, versionExpression)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch current state")
+	}
+	if !current.IsDraft() {
+		return errors.Wrapf(ErrInvalidArgument, "version %s is %s, not a draft", versionExpression, *current)
+	}
+
+	return api.UpdateArtifactVersionState(ctx, groupId, artifactId, versionExpression, models.StateEnabled, false)
+}
+
+// DryRunArtifactVersionState asks the server whether the state of an artifact version could be changed,
+// without changing it. A transition refused by the server (400 or 409) is reported as a result that is not
+// allowed, together with the reasons the server gave; other failures are returned as errors.
+func (api *VersionsAPI) DryRunArtifactVersionState(
+	ctx context.Context,
+	groupId, artifactId, versionExpression string,
+	state models.State,
+) (*models.StateChangeResult, error) {
+	err := api.UpdateArtifactVersionState(ctx, groupId, artifactId, versionExpression, state, true)
+	if err == nil {
+		return &models.StateChangeResult{Allowed: true}, nil
+	}
+
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) || (apiErr.Status != http.StatusBadRequest && apiErr.Status != http.StatusConflict) {
+		return nil, err
+	}
+
+	result := &models.StateChangeResult{Allowed: false}
+	for _, reason := range []string{apiErr.Title, apiErr.Detail} {
+		if reason != "" {
+			result.Reasons = append(result.Reasons, reason)
+		}
+	}
+	return result, nil
+}
+
+// GetVersionContentByGlobalID gets the content for an artifact version in the registry using its globally unique identifier.
+// This is the identifier typically carried by serialized messages (e.g. in a Kafka message header).
+// See: https://www.apicur.io/registry/docs/apicurio-registry/3.0.x/assets-attachments/registry-rest-api.htm#tag/Artifacts/operation/getContentByGlobalId
+func (api *VersionsAPI) GetVersionContentByGlobalID(
+	ctx context.Context,
+	globalID int64,
+	params *models.ArtifactReferenceParams,
+) (*models.ArtifactContent, error) {
+	query := ""
+	if params != nil {
+		query = "?" + params.ToQuery().Encode()
+	}
+	url := api.Client.URL("ids", "globalIds", strconv.FormatInt(globalID, 10)) + query
+
+	resp, err := api.executeRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer drainAndClose(resp.Body)
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrArtifactNotFound, "global ID: %d", globalID)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		apiError, parseErr := parseAPIError(resp)
+		if parseErr != nil {
+			return nil, errors.Wrap(parseErr, "unexpected error")
+		}
+		return nil, apiError
+	}
+
+	// Parse artifact type header
+	artifactType, err := parseArtifactTypeHeader(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the response body
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+
+	return &models.ArtifactContent{
+		Content:      string(content),
+		ArtifactType: artifactType,
+	}, nil
+}
+
 // executeRequest handles the creation and execution of an HTTP request.
-func (api *VersionsAPI) executeRequest(ctx context.Context, method, url string, body interface{}) (*http.Response, error) {
+func (api *VersionsAPI) executeRequest(ctx context.Context, method, url string, body interface{}, opts ...requestOption) (*http.Response, error) {
 	var reqBody []byte
 	var err error
 	contentType := "*/*"
@@ -545,11 +1209,14 @@ func (api *VersionsAPI) executeRequest(ctx context.Context, method, url string,
 	if body != nil {
 		req.Header.Set("Content-Type", contentType)
 	}
+	for _, opt := range opts {
+		opt(req)
+	}
 
 	// Execute the request
 	resp, err := api.Client.Do(req)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to execute HTTP request")
+		return nil, requestError(ctx, err)
 	}
 
 	return resp, nil
@@ -1,6 +1,7 @@
 package apis_test
 
 import (
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
@@ -12,6 +13,9 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -109,6 +113,21 @@ func TestVersionsAPI_DeleteArtifactVersion(t *testing.T) {
 		// Assertions
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "Method not allowed")
+		assert.True(t, errors.Is(err, apis.ErrVersionDeletionDisabled))
+		assert.Contains(t, err.Error(), "registry.rest.artifact.deletion.enabled")
+	})
+
+	t.Run("Method Not Allowed Without Body", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		err := api.DeleteArtifactVersion(context.Background(), "test-group", "test-artifact", "1.0.0")
+		assert.True(t, errors.Is(err, apis.ErrVersionDeletionDisabled))
 	})
 
 	t.Run("Internal Server Error", func(t *testing.T) {
@@ -141,6 +160,56 @@ func TestVersionsAPI_DeleteArtifactVersion(t *testing.T) {
 	})
 }
 
+func TestVersionsAPI_DeleteArtifactVersions(t *testing.T) {
+	t.Run("MixedResults", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			if strings.HasSuffix(r.URL.Path, "/versions/2.0.0") {
+				w.WriteHeader(http.StatusNotFound)
+				err := json.NewEncoder(w).Encode(models.APIError{Status: http.StatusNotFound, Title: "not found"})
+				assert.NoError(t, err)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		deleted, failed := api.DeleteArtifactVersions(context.Background(), "test-group", "test-artifact",
+			[]string{"1.0.0", "2.0.0", "3.0.0", "4.0.0", "5.0.0", "6.0.0"})
+
+		assert.Equal(t, []string{"1.0.0", "3.0.0", "4.0.0", "5.0.0", "6.0.0"}, deleted)
+		assert.Len(t, failed, 1)
+
+		var apiErr *models.APIError
+		assert.True(t, errors.As(failed["2.0.0"], &apiErr))
+		assert.Equal(t, http.StatusNotFound, apiErr.Status)
+	})
+
+	t.Run("CancelledContext", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		deleted, failed := api.DeleteArtifactVersions(ctx, "test-group", "test-artifact", []string{"1.0.0", "2.0.0"})
+		assert.Empty(t, deleted)
+		assert.Len(t, failed, 2)
+		assert.True(t, errors.Is(failed["1.0.0"], context.Canceled))
+		assert.Equal(t, int32(0), atomic.LoadInt32(&requests))
+	})
+}
+
 func TestVersionsAPI_GetArtifactVersionReferences(t *testing.T) {
 	t.Run("Success with Parameters", func(t *testing.T) {
 		mockResponse := []models.ArtifactReference{
@@ -286,6 +355,95 @@ func TestVersionsAPI_GetArtifactVersionReferences(t *testing.T) {
 	})
 }
 
+func TestVersionsAPI_InboundOutboundReferences(t *testing.T) {
+	var gotQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/groups/test-group/artifacts/artifact-1/versions/1/references", r.URL.Path)
+		gotQuery = r.URL.RawQuery
+
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode([]models.ArtifactReference{{GroupID: "test-group", ArtifactID: "artifact-2", Version: "1"}})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewVersionsAPI(mockClient)
+
+	t.Run("Inbound", func(t *testing.T) {
+		result, err := api.InboundReferences(context.Background(), "test-group", "artifact-1", "1")
+		assert.NoError(t, err)
+		assert.Len(t, *result, 1)
+		assert.Equal(t, "refType=INBOUND", gotQuery)
+	})
+
+	t.Run("Outbound", func(t *testing.T) {
+		result, err := api.OutboundReferences(context.Background(), "test-group", "artifact-1", "1")
+		assert.NoError(t, err)
+		assert.Len(t, *result, 1)
+		assert.Equal(t, "refType=OUTBOUND", gotQuery)
+	})
+}
+
+func TestVersionsAPI_ListVersionBranches(t *testing.T) {
+	branchVersions := map[string][]string{
+		"latest":  {"2.0.0"},
+		"drafts":  {"3.0.0"},
+		"release": {"2.0.0", "1.0.0"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		const prefix = "/groups/test-group/artifacts/artifact-1"
+		switch {
+		case r.URL.Path == prefix+"/versions/2.0.0":
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.ArtifactVersionMetadata{Version: "2.0.0"})
+			assert.NoError(t, err)
+		case r.URL.Path == prefix+"/branches":
+			assert.Equal(t, "0", r.URL.Query().Get("offset"))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.BranchListResponse{
+				Count:    3,
+				Branches: []models.Branch{{BranchID: "latest"}, {BranchID: "drafts"}, {BranchID: "release"}},
+			})
+			assert.NoError(t, err)
+		case strings.HasPrefix(r.URL.Path, prefix+"/branches/"):
+			branchID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, prefix+"/branches/"), "/versions")
+			response := models.ArtifactVersionListResponse{}
+			for _, version := range branchVersions[branchID] {
+				response.Versions = append(response.Versions, models.ArtifactVersion{Version: version})
+			}
+			response.Count = len(response.Versions)
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(response)
+			assert.NoError(t, err)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			err := json.NewEncoder(w).Encode(models.APIError{Status: 404, Title: "VersionNotFoundException"})
+			assert.NoError(t, err)
+		}
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewVersionsAPI(mockClient)
+
+	t.Run("Success", func(t *testing.T) {
+		branches, err := api.ListVersionBranches(context.Background(), "test-group", "artifact-1", "2.0.0")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"latest", "release"}, branches)
+	})
+
+	t.Run("UnknownVersion", func(t *testing.T) {
+		branches, err := api.ListVersionBranches(context.Background(), "test-group", "artifact-1", "9.9.9")
+		assert.Error(t, err)
+		assert.Nil(t, branches)
+		var apiErr *models.APIError
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, 404, apiErr.Status)
+	})
+}
+
 func TestVersionsAPI_GetArtifactVersionComments(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockResponse := []models.ArtifactComment{
@@ -305,7 +463,7 @@ func TestVersionsAPI_GetArtifactVersionComments(t *testing.T) {
 		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
 		api := apis.NewVersionsAPI(mockClient)
 
-		result, err := api.GetArtifactVersionComments(context.Background(), "test-group", "artifact-1", "1")
+		result, err := api.GetArtifactVersionComments(context.Background(), "test-group", "artifact-1", "1", nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Equal(t, 1, len(*result))
@@ -324,7 +482,7 @@ func TestVersionsAPI_GetArtifactVersionComments(t *testing.T) {
 		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
 		api := apis.NewVersionsAPI(mockClient)
 
-		result, err := api.GetArtifactVersionComments(context.Background(), "test-group", "artifact-1", "invalid-version")
+		result, err := api.GetArtifactVersionComments(context.Background(), "test-group", "artifact-1", "invalid-version", nil)
 		assert.Error(t, err)
 		assert.Nil(t, result)
 		var apiErr *models.APIError
@@ -346,7 +504,7 @@ func TestVersionsAPI_GetArtifactVersionComments(t *testing.T) {
 		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
 		api := apis.NewVersionsAPI(mockClient)
 
-		result, err := api.GetArtifactVersionComments(context.Background(), "non-existent-group", "non-existent-artifact", "1")
+		result, err := api.GetArtifactVersionComments(context.Background(), "non-existent-group", "non-existent-artifact", "1", nil)
 		assert.Error(t, err)
 		assert.Nil(t, result)
 		var apiErr *models.APIError
@@ -368,7 +526,7 @@ func TestVersionsAPI_GetArtifactVersionComments(t *testing.T) {
 		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
 		api := apis.NewVersionsAPI(mockClient)
 
-		result, err := api.GetArtifactVersionComments(context.Background(), "test-group", "artifact-1", "1")
+		result, err := api.GetArtifactVersionComments(context.Background(), "test-group", "artifact-1", "1", nil)
 		assert.Error(t, err)
 		assert.Nil(t, result)
 		var apiErr *models.APIError
@@ -377,6 +535,44 @@ func TestVersionsAPI_GetArtifactVersionComments(t *testing.T) {
 		assert.Equal(t, "Internal Server Error", apiErr.Title)
 		assert.Equal(t, "An unexpected error occurred", apiErr.Detail)
 	})
+	t.Run("OrderAndLimit", func(t *testing.T) {
+		mockResponse := []models.ArtifactComment{
+			{CommentID: "2", Value: "second", CreatedOn: "2023-07-02T10:00:00Z"},
+			{CommentID: "3", Value: "third", CreatedOn: "2023-07-03T10:00:00Z"},
+			{CommentID: "1", Value: "first", CreatedOn: "2023-07-01T10:00:00Z"},
+			{CommentID: "4", Value: "fourth", CreatedOn: "2023-07-03T10:00:00Z"},
+		}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.URL.RawQuery)
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(mockResponse)
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		values := func(comments *[]models.ArtifactComment) []string {
+			var result []string
+			for _, c := range *comments {
+				result = append(result, c.Value)
+			}
+			return result
+		}
+
+		result, err := api.GetArtifactVersionComments(context.Background(), "test-group", "artifact-1", "1", &models.CommentListParams{Order: models.OrderAsc})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"first", "second", "third", "fourth"}, values(result))
+
+		result, err = api.GetArtifactVersionComments(context.Background(), "test-group", "artifact-1", "1", &models.CommentListParams{Order: models.OrderDesc, Limit: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"fourth", "third"}, values(result))
+
+		result, err = api.GetArtifactVersionComments(context.Background(), "test-group", "artifact-1", "1", &models.CommentListParams{Limit: -1})
+		assert.True(t, errors.Is(err, apis.ErrInvalidArgument))
+		assert.Nil(t, result)
+	})
 }
 
 func TestVersionsAPI_AddArtifactVersionComment(t *testing.T) {
@@ -489,6 +685,38 @@ func TestVersionsAPI_AddArtifactVersionComment(t *testing.T) {
 	})
 }
 
+func TestVersionsAPI_AddCommentAndList(t *testing.T) {
+	comments := []models.ArtifactComment{
+		{CommentID: "1", Value: "first", CreatedOn: "2024-01-01T10:00:00Z"},
+		{CommentID: "2", Value: "second", CreatedOn: "2024-01-02T10:00:00Z"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/groups/my-group/artifacts/example-artifact/versions/1.0.0/comments", r.URL.Path)
+		switch r.Method {
+		case http.MethodPost:
+			// Same timestamp as comment 2, so only the created ID can put it first.
+			created := models.ArtifactComment{CommentID: "10", Value: "third", CreatedOn: "2024-01-02T10:00:00Z"}
+			comments = append(comments, created)
+			err := json.NewEncoder(w).Encode(created)
+			assert.NoError(t, err)
+		case http.MethodGet:
+			err := json.NewEncoder(w).Encode(comments)
+			assert.NoError(t, err)
+		}
+	}))
+	defer server.Close()
+
+	api := apis.NewVersionsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+
+	result, err := api.AddCommentAndList(context.Background(), "my-group", "example-artifact", "1.0.0", "third")
+	assert.NoError(t, err)
+	var ids []string
+	for _, comment := range *result {
+		ids = append(ids, comment.CommentID)
+	}
+	assert.Equal(t, []string{"10", "2", "1"}, ids)
+}
+
 func TestVersionsAPI_UpdateArtifactVersionComment(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -655,6 +883,65 @@ func TestVersionsAPI_DeleteArtifactVersionComment(t *testing.T) {
 	})
 }
 
+func TestVersionsAPI_DeleteAllArtifactVersionComments(t *testing.T) {
+	newServer := func(t *testing.T, missing map[string]bool, deleted *int32) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			const base = "/groups/my-group/artifacts/example-artifact/versions/v1/comments"
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == base:
+				comments := []models.ArtifactComment{{CommentID: "1"}, {CommentID: "2"}, {CommentID: "3"}}
+				err := json.NewEncoder(w).Encode(comments)
+				assert.NoError(t, err)
+			case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, base+"/"):
+				if missing[strings.TrimPrefix(r.URL.Path, base+"/")] {
+					w.WriteHeader(http.StatusNotFound)
+					err := json.NewEncoder(w).Encode(models.APIError{Status: 404, Title: "Comment not found"})
+					assert.NoError(t, err)
+					return
+				}
+				atomic.AddInt32(deleted, 1)
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			}
+		}))
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		var deleted int32
+		server := newServer(t, nil, &deleted)
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		err := api.DeleteAllArtifactVersionComments(context.Background(), "my-group", "example-artifact", "v1")
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&deleted))
+	})
+
+	t.Run("Partial Failure", func(t *testing.T) {
+		var deleted int32
+		server := newServer(t, map[string]bool{"1": true, "3": true}, &deleted)
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		err := api.DeleteAllArtifactVersionComments(context.Background(), "my-group", "example-artifact", "v1")
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&deleted))
+		assert.Contains(t, err.Error(), "failed to delete comment 1")
+		assert.Contains(t, err.Error(), "failed to delete comment 3")
+		assert.NotContains(t, err.Error(), "failed to delete comment 2")
+
+		var apiErr *models.APIError
+		ok := errors.As(err, &apiErr)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, apiErr.Status)
+	})
+}
+
 func TestVersionsAPI_ListArtifactVersions(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockResponse := models.ArtifactVersionListResponse{
@@ -752,7 +1039,228 @@ func TestVersionsAPI_ListArtifactVersions(t *testing.T) {
 	})
 }
 
+func TestVersionsAPI_ListArtifactVersionsFiltered(t *testing.T) {
+	states := []models.State{models.StateEnabled, models.StateDisabled, models.StateDeprecated}
+	var all []models.ArtifactVersion
+	for i := 0; i < 150; i++ {
+		all = append(all, models.ArtifactVersion{Version: fmt.Sprintf("1.0.%d", i), State: states[i%len(states)]})
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/groups/my-group/artifacts/example-artifact/versions", r.URL.Path)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		end := offset + limit
+		if end > len(all) {
+			end = len(all)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(models.ArtifactVersionListResponse{Count: len(all), Versions: all[offset:end]})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewVersionsAPI(mockClient)
+
+	t.Run("FilteredStates", func(t *testing.T) {
+		versions, err := api.ListArtifactVersionsFiltered(context.Background(), "my-group", "example-artifact", models.StateEnabled, models.StateDeprecated)
+		assert.NoError(t, err)
+		assert.Len(t, *versions, 100)
+		for _, version := range *versions {
+			assert.NotEqual(t, models.StateDisabled, version.State)
+		}
+	})
+
+	t.Run("NoStates", func(t *testing.T) {
+		versions, err := api.ListArtifactVersionsFiltered(context.Background(), "my-group", "example-artifact")
+		assert.NoError(t, err)
+		assert.Len(t, *versions, 150)
+	})
+
+	t.Run("UnknownState", func(t *testing.T) {
+		versions, err := api.ListArtifactVersionsFiltered(context.Background(), "my-group", "example-artifact", "ARCHIVED")
+		assert.ErrorIs(t, err, apis.ErrInvalidArgument)
+		assert.Nil(t, versions)
+	})
+
+	t.Run("ServerCapsPageSize", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			end := offset + 20
+			if end > len(all) {
+				end = len(all)
+			}
+
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.ArtifactVersionListResponse{Count: len(all), Versions: all[offset:end]})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		versions, err := api.ListArtifactVersionsFiltered(context.Background(), "my-group", "example-artifact")
+		assert.NoError(t, err)
+		assert.Len(t, *versions, 150)
+		assert.Equal(t, int32(8), atomic.LoadInt32(&requests))
+	})
+}
+
+func TestVersionsAPI_ForEachArtifactVersion(t *testing.T) {
+	var all []models.ArtifactVersion
+	for i := 0; i < 5; i++ {
+		all = append(all, models.ArtifactVersion{Version: fmt.Sprintf("1.0.%d", i)})
+	}
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/groups/my-group/artifacts/example-artifact/versions", r.URL.Path)
+		assert.Equal(t, "version", r.URL.Query().Get("orderby"))
+		atomic.AddInt32(&requests, 1)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		end := offset + limit
+		if end > len(all) {
+			end = len(all)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(models.ArtifactVersionListResponse{Count: len(all), Versions: all[offset:end]})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewVersionsAPI(mockClient)
+	params := &models.ListVersionsParams{Limit: 3, OrderBy: models.OrderByVersion}
+
+	t.Run("Every Version In Order", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		var seen []string
+		err := api.ForEachArtifactVersion(context.Background(), "my-group", "example-artifact", params, func(version models.ArtifactVersion) error {
+			seen = append(seen, version.Version)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1.0.0", "1.0.1", "1.0.2", "1.0.3", "1.0.4"}, seen)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+		assert.Equal(t, 0, params.Offset)
+	})
+
+	t.Run("Callback Error", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		errStop := errors.New("stop")
+		var seen []string
+		err := api.ForEachArtifactVersion(context.Background(), "my-group", "example-artifact", params, func(version models.ArtifactVersion) error {
+			seen = append(seen, version.Version)
+			if version.Version == "1.0.1" {
+				return errStop
+			}
+			return nil
+		})
+		assert.ErrorIs(t, err, errStop)
+		assert.Equal(t, []string{"1.0.0", "1.0.1"}, seen)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("Context Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var seen int
+		err := api.ForEachArtifactVersion(ctx, "my-group", "example-artifact", params, func(version models.ArtifactVersion) error {
+			seen++
+			cancel()
+			return nil
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, seen)
+	})
+}
+
+func TestVersionsAPI_ListArtifactVersions_Params(t *testing.T) {
+	var rawQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rawQuery = r.URL.RawQuery
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(models.ArtifactVersionListResponse{})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewVersionsAPI(mockClient)
+
+	orderings := []models.OrderBy{
+		models.OrderByGroupId,
+		models.OrderByArtifactId,
+		models.OrderByVersion,
+		models.OrderByName,
+		models.OrderByCreatedOn,
+		models.OrderByModifiedOn,
+		models.OrderByGlobalId,
+	}
+	for _, orderBy := range orderings {
+		t.Run(string(orderBy), func(t *testing.T) {
+			params := &models.ListVersionsParams{Limit: 5, Offset: 10, Order: models.OrderDesc, OrderBy: orderBy}
+			_, err := api.ListArtifactVersions(context.Background(), "my-group", "example-artifact", params)
+			assert.NoError(t, err)
+			assert.Equal(t, "limit=5&offset=10&order=desc&orderby="+string(orderBy), rawQuery)
+		})
+	}
+
+	t.Run("InvalidParams", func(t *testing.T) {
+		invalid := []*models.ListVersionsParams{
+			{Limit: -1},
+			{Offset: -1},
+			{Order: "sideways"},
+			{OrderBy: "artifactType"},
+		}
+		for _, params := range invalid {
+			rawQuery = "unchanged"
+			versions, err := api.ListArtifactVersions(context.Background(), "my-group", "example-artifact", params)
+			assert.Nil(t, versions)
+			assert.True(t, errors.Is(err, apis.ErrInvalidArgument))
+			assert.Equal(t, "unchanged", rawQuery)
+		}
+	})
+
+	t.Run("LegacyParams", func(t *testing.T) {
+		params := &models.ListArtifactsInGroupParams{Limit: 1, Order: "asc", OrderBy: "createdOn"}
+		_, err := api.ListArtifactVersionsLegacy(context.Background(), "my-group", "example-artifact", params)
+		assert.NoError(t, err)
+		assert.Equal(t, "limit=1&order=asc&orderby=createdOn", rawQuery)
+	})
+}
+
 func TestVersionsAPI_CreateArtifactVersion(t *testing.T) {
+	t.Run("Artifact Type Header", func(t *testing.T) {
+		var artifactTypes []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			artifactTypes = append(artifactTypes, r.Header.Get("X-Registry-ArtifactType"))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.ArtifactVersionDetailed{})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		for _, artifactType := range []models.ArtifactType{models.KConnect, ""} {
+			request := &models.CreateVersionRequest{
+				Content:      models.CreateContentRequest{Content: `{"type":"struct"}`},
+				ArtifactType: artifactType,
+			}
+			_, err := api.CreateArtifactVersion(context.Background(), "my-group", "example-artifact", request, false)
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, []string{string(models.KConnect), ""}, artifactTypes)
+	})
 
 	t.Run("Success", func(t *testing.T) {
 
@@ -960,60 +1468,626 @@ func TestVersionsAPI_CreateArtifactVersion(t *testing.T) {
 
 }
 
-func TestVersionsAPI_GetArtifactVersionContent(t *testing.T) {
-	t.Run("Success", func(t *testing.T) {
-		mockResponse := `{"a": "1"}`
-
-		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			assert.Equal(t, "/groups/my-group/artifacts/example-artifact/versions/1.0.0/content", r.URL.Path)
-			assert.Equal(t, http.MethodGet, r.Method)
-			// Write the response
-			w.Header().Set("X-Registry-ArtifactType", string(models.Json))
-			w.WriteHeader(http.StatusOK)
-			_, err := w.Write([]byte(mockResponse))
-			assert.NoError(t, err)
+func TestVersionsAPI_CreateArtifactVersion_JSONSchemaValidation(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(models.ArtifactVersionDetailed{})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
 
-		}))
-		defer server.Close()
+	mockClient := client.NewClient(server.URL, client.WithHTTPClient(server.Client()), client.WithJSONSchemaValidation())
+	api := apis.NewVersionsAPI(mockClient)
 
-		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
-		api := apis.NewVersionsAPI(mockClient)
+	t.Run("InvalidSchemaIsRejectedLocally", func(t *testing.T) {
+		request := &models.CreateVersionRequest{
+			ArtifactType: models.Json,
+			Content:      models.CreateContentRequest{Content: `{"properties": {"a": 1}}`},
+		}
+		res, err := api.CreateArtifactVersion(context.Background(), "my-group", "example-artifact", request, false)
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.True(t, errors.Is(err, models.ErrInvalidJSONSchema))
+		assert.False(t, called)
+	})
 
-		content, err := api.GetArtifactVersionContent(context.Background(), "my-group", "example-artifact", "1.0.0", nil)
+	t.Run("OtherArtifactTypesAreNotChecked", func(t *testing.T) {
+		request := &models.CreateVersionRequest{
+			ArtifactType: models.Avro,
+			Content:      models.CreateContentRequest{Content: stubNewContent},
+		}
+		res, err := api.CreateArtifactVersion(context.Background(), "my-group", "example-artifact", request, false)
 		assert.NoError(t, err)
-		assert.NotEmpty(t, content)
-		assert.Equal(t, `{"a": "1"}`, content.Content)
+		assert.NotNil(t, res)
+		assert.True(t, called)
 	})
+}
 
-	t.Run("BadRequest", func(t *testing.T) {
-		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(http.StatusNotFound)
-			err := json.NewEncoder(w).Encode(models.APIError{Status: 400, Title: "bad request"})
-			assert.NoError(t, err)
-		}))
-		defer server.Close()
+func TestVersionsAPI_CreateArtifactVersion_ArtifactTypeValidation(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(models.ArtifactVersionDetailed{})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
 
-		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
-		api := apis.NewVersionsAPI(mockClient)
+	api := apis.NewVersionsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
 
-		version, err := api.GetArtifactVersionContent(context.Background(), "my-group", "example-artifact", "1.0.0", nil)
+	t.Run("UnknownTypeIsRejectedLocally", func(t *testing.T) {
+		request := &models.CreateVersionRequest{
+			ArtifactType: "PROTO",
+			Content:      models.CreateContentRequest{Content: "syntax = \"proto3\";"},
+		}
+		res, err := api.CreateArtifactVersion(context.Background(), "my-group", "example-artifact", request, false)
 		assert.Error(t, err)
-		assert.Nil(t, version)
-
-		var apiErr *models.APIError
-		ok := errors.As(err, &apiErr)
-		assert.True(t, ok)
-		assert.Equal(t, 400, apiErr.Status)
-		assert.Equal(t, "bad request", apiErr.Title)
+		assert.Nil(t, res)
+		assert.True(t, errors.Is(err, models.ErrUnknownArtifactType))
+		assert.False(t, called)
 	})
 
-	t.Run("NotFound", func(t *testing.T) {
-		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(http.StatusNotFound)
-			err := json.NewEncoder(w).Encode(models.APIError{Status: 404, Title: "not found"})
+	t.Run("KnownOrOmittedType", func(t *testing.T) {
+		for _, artifactType := range []models.ArtifactType{models.Protobuf, ""} {
+			called = false
+			request := &models.CreateVersionRequest{
+				ArtifactType: artifactType,
+				Content:      models.CreateContentRequest{Content: "syntax = \"proto3\";"},
+			}
+			res, err := api.CreateArtifactVersion(context.Background(), "my-group", "example-artifact", request, false)
 			assert.NoError(t, err)
-		}))
-		defer server.Close()
+			assert.NotNil(t, res)
+			assert.True(t, called)
+		}
+	})
+}
+
+func TestVersionsAPI_CreateArtifactVersion_WithReferences(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		content := body["content"].(map[string]interface{})
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"groupId": "my-group", "artifactId": "common", "version": "2", "name": "common.proto"},
+		}, content["references"])
+
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(models.ArtifactVersionDetailed{})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewVersionsAPI(mockClient)
+
+	refs := []models.ArtifactReference{{GroupID: "my-group", ArtifactID: "common", Version: "2", Name: "common.proto"}}
+	request := &models.CreateVersionRequest{
+		ArtifactType: models.Protobuf,
+		Content:      models.ContentWithReferences(`syntax = "proto3"; import "common.proto";`, refs),
+	}
+	res, err := api.CreateArtifactVersion(context.Background(), "my-group", "example-artifact", request, false)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+}
+
+func TestVersionsAPI_CreateArtifactVersion_Identifiers(t *testing.T) {
+	request := &models.CreateVersionRequest{
+		Version: "2.0.0",
+		Content: models.CreateContentRequest{Content: stubNewContent, ContentType: "application/json"},
+	}
+
+	t.Run("IDsInBody", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Location", "/ids/globalIds/99")
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.ArtifactVersionDetailed{
+				ArtifactVersion: models.ArtifactVersion{Version: "2.0.0", GlobalID: 42, ContentID: 7},
+			})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		api := apis.NewVersionsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		res, err := api.CreateArtifactVersion(context.Background(), "my-group", "example-artifact", request, false)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), res.GlobalID)
+		assert.Equal(t, int64(7), res.ContentID)
+	})
+
+	t.Run("IDsFromLocation", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Location", "http://registry/apis/registry/v3/ids/globalIds/42")
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.ArtifactVersionDetailed{})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		api := apis.NewVersionsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		res, err := api.CreateArtifactVersion(context.Background(), "my-group", "example-artifact", request, false)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), res.GlobalID)
+	})
+
+	t.Run("CoordinatesFromLocation", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Location", "/apis/registry/v3/groups/my-group/artifacts/com%2Fexample/versions/2.0.0")
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.ArtifactVersionDetailed{})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		api := apis.NewVersionsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		res, err := api.CreateArtifactVersion(context.Background(), "my-group", "example-artifact", request, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "my-group", res.GroupID)
+		assert.Equal(t, "com/example", res.ArtifactID)
+		assert.Equal(t, "2.0.0", res.Version)
+		assert.Zero(t, res.GlobalID)
+	})
+
+	t.Run("MalformedVersionInLocation", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Location", "/apis/registry/v3/groups/my-group/artifacts/example-artifact/versions/2.0%200")
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.ArtifactVersionDetailed{})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		api := apis.NewVersionsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		res, err := api.CreateArtifactVersion(context.Background(), "my-group", "example-artifact", request, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "example-artifact", res.ArtifactID)
+		assert.Empty(t, res.Version)
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "dryRun=true", r.URL.RawQuery)
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.ArtifactVersionDetailed{
+				ArtifactVersion: models.ArtifactVersion{Version: "2.0.0", ArtifactID: "example-artifact"},
+			})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		api := apis.NewVersionsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		res, err := api.CreateArtifactVersion(context.Background(), "my-group", "example-artifact", request, true)
+		assert.NoError(t, err)
+		assert.Equal(t, "2.0.0", res.Version)
+		assert.Zero(t, res.GlobalID)
+		assert.Zero(t, res.ContentID)
+	})
+}
+
+func TestVersionsAPI_CreateArtifactVersion_PreservedIDs(t *testing.T) {
+	request := &models.CreateVersionRequest{
+		Content: models.CreateContentRequest{Content: stubNewContent, ContentType: "application/json"},
+	}
+
+	var headers []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Clone())
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(models.ArtifactVersionDetailed{})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	api := apis.NewVersionsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+
+	_, err := api.CreateArtifactVersion(apis.WithPreservedIDs(context.Background(), 42, 0), "my-group", "example-artifact", request, false)
+	assert.NoError(t, err)
+	_, err = api.CreateArtifactVersion(context.Background(), "my-group", "example-artifact", request, false)
+	assert.NoError(t, err)
+
+	assert.Len(t, headers, 2)
+	assert.Equal(t, "42", headers[0].Get("X-Registry-Preserve-GlobalId"))
+	assert.Empty(t, headers[0].Values("X-Registry-Preserve-ContentId"))
+	assert.Empty(t, headers[1].Values("X-Registry-Preserve-GlobalId"))
+	assert.Empty(t, headers[1].Values("X-Registry-Preserve-ContentId"))
+}
+
+func TestVersionsAPI_CreateArtifactVersion_IdempotencyKey(t *testing.T) {
+	request := &models.CreateVersionRequest{
+		Content: models.CreateContentRequest{Content: stubNewContent, ContentType: "application/json"},
+	}
+
+	// The stub creates one version per idempotency key, replaying the first response for a repeated key.
+	created := map[string]int64{}
+	var withoutKey int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("X-Idempotency-Key")
+		if key == "" {
+			withoutKey++
+		} else if _, ok := created[key]; !ok {
+			created[key] = int64(len(created) + 1)
+		}
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(models.ArtifactVersionDetailed{
+			ArtifactVersion: models.ArtifactVersion{GlobalID: created[key]},
+		})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	api := apis.NewVersionsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+
+	first, err := api.CreateArtifactVersion(apis.WithIdempotencyKey(context.Background(), "key-1"), "my-group", "example-artifact", request, false)
+	assert.NoError(t, err)
+	retried, err := api.CreateArtifactVersion(apis.WithIdempotencyKey(context.Background(), "key-1"), "my-group", "example-artifact", request, false)
+	assert.NoError(t, err)
+	other, err := api.CreateArtifactVersion(apis.WithIdempotencyKey(context.Background(), "key-2"), "my-group", "example-artifact", request, false)
+	assert.NoError(t, err)
+	_, err = api.CreateArtifactVersion(context.Background(), "my-group", "example-artifact", request, false)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(1), first.GlobalID)
+	assert.Equal(t, first.GlobalID, retried.GlobalID)
+	assert.Equal(t, int64(2), other.GlobalID)
+	assert.Len(t, created, 2)
+	assert.Equal(t, 1, withoutKey)
+}
+
+func TestVersionsAPI_CheckCompatibility(t *testing.T) {
+	content := models.CreateContentRequest{Content: stubNewContent, ContentType: "application/json"}
+
+	t.Run("Compatible", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/groups/my-group/artifacts/example-artifact/versions", r.URL.Path)
+			assert.Equal(t, "dryRun=true", r.URL.RawQuery)
+
+			var body models.CreateVersionRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, stubNewContent, body.Content.Content)
+
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.ArtifactVersionDetailed{})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		api := apis.NewVersionsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		result, err := api.CheckCompatibility(context.Background(), "my-group", "example-artifact", content)
+		assert.NoError(t, err)
+		assert.True(t, result.Compatible)
+		assert.Empty(t, result.Reasons)
+	})
+
+	t.Run("Incompatible", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusConflict)
+			err := json.NewEncoder(w).Encode(models.APIError{
+				Status: 409,
+				Title:  "Incompatible artifact",
+				Name:   "RuleViolationException",
+				Causes: []models.RuleViolationCause{
+					{Description: "FIELD_REMOVED", Context: "/properties/name"},
+					{Description: "TYPE_CHANGED"},
+				},
+			})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		api := apis.NewVersionsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		result, err := api.CheckCompatibility(context.Background(), "my-group", "example-artifact", content)
+		assert.NoError(t, err)
+		assert.False(t, result.Compatible)
+		assert.Equal(t, []string{"FIELD_REMOVED (at /properties/name)", "TYPE_CHANGED"}, result.Reasons)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			err := json.NewEncoder(w).Encode(models.APIError{Status: 404, Title: "Artifact not found"})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		api := apis.NewVersionsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+		result, err := api.CheckCompatibility(context.Background(), "my-group", "example-artifact", content)
+		assert.True(t, models.IsNotFound(err))
+		assert.Nil(t, result)
+	})
+}
+
+func TestVersionsAPI_CreateArtifactVersionFromReader(t *testing.T) {
+	var large strings.Builder
+	for large.Len() < 4<<20 {
+		large.WriteString("line \"quoted\" \\ with\ttab and unicode é\n")
+	}
+	content := large.String()
+
+	var gotLength int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/groups/my-group/artifacts/example-artifact/versions", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		gotLength = r.ContentLength
+
+		var body models.CreateVersionRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "2.0.0", body.Version)
+		assert.Equal(t, "application/x-yaml", body.Content.ContentType)
+		assert.Equal(t, len(content), len(body.Content.Content))
+		assert.True(t, body.Content.Content == content)
+
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(models.ArtifactVersionDetailed{ArtifactVersion: models.ArtifactVersion{Version: "2.0.0", GlobalID: 42}})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewVersionsAPI(mockClient)
+	newRequest := func() *models.CreateVersionRequest {
+		return &models.CreateVersionRequest{
+			Version: "2.0.0",
+			Content: models.CreateContentRequest{ContentType: "application/x-yaml"},
+		}
+	}
+
+	t.Run("Seekable", func(t *testing.T) {
+		res, err := api.CreateArtifactVersionFromReader(context.Background(), "my-group", "example-artifact", newRequest(), strings.NewReader(content), false)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), res.GlobalID)
+		assert.Greater(t, gotLength, int64(len(content)))
+	})
+
+	t.Run("Unknown Length", func(t *testing.T) {
+		reader := struct{ io.Reader }{strings.NewReader(content)}
+		res, err := api.CreateArtifactVersionFromReader(context.Background(), "my-group", "example-artifact", newRequest(), reader, false)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), res.GlobalID)
+		assert.Equal(t, int64(-1), gotLength)
+	})
+
+	t.Run("Inline Content Rejected", func(t *testing.T) {
+		request := newRequest()
+		request.Content.Content = "inline"
+		res, err := api.CreateArtifactVersionFromReader(context.Background(), "my-group", "example-artifact", request, strings.NewReader(content), false)
+		assert.ErrorIs(t, err, apis.ErrInvalidArgument)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Request Editor Error Releases Reader", func(t *testing.T) {
+		errSigning := errors.New("signing key unavailable")
+		failingClient := client.NewClient("http://registry.invalid", client.WithRequestEditor(func(ctx context.Context, req *http.Request) error {
+			return errSigning
+		}))
+		api := apis.NewVersionsAPI(failingClient)
+
+		reader := &releaseRecorder{content: content, released: make(chan struct{})}
+		res, err := api.CreateArtifactVersionFromReader(context.Background(), "my-group", "example-artifact", newRequest(), reader, false)
+		assert.ErrorIs(t, err, errSigning)
+		assert.Nil(t, res)
+		select {
+		case <-reader.released:
+		case <-time.After(5 * time.Second):
+			t.Fatal("content reader was not released after the request editor failed")
+		}
+	})
+}
+
+// releaseRecorder is streamed content that signals once the request body stops accepting it.
+type releaseRecorder struct {
+	content  string
+	released chan struct{}
+}
+
+func (r *releaseRecorder) Read(p []byte) (int, error) {
+	return 0, errors.New("content is written with WriteTo")
+}
+
+func (r *releaseRecorder) WriteTo(w io.Writer) (int64, error) {
+	defer close(r.released)
+	var written int64
+	for {
+		n, err := io.WriteString(w, r.content)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+}
+
+func TestVersionsAPI_CreateArtifactVersion_ContentTypeInference(t *testing.T) {
+	var received models.CreateVersionRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+
+		w.WriteHeader(http.StatusOK)
+		err = json.NewEncoder(w).Encode(models.ArtifactVersionDetailed{})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewVersionsAPI(mockClient)
+
+	t.Run("EmptyContentTypeIsInferred", func(t *testing.T) {
+		request := &models.CreateVersionRequest{
+			ArtifactType: models.Protobuf,
+			Content:      models.CreateContentRequest{Content: `syntax = "proto3";`},
+		}
+		_, err := api.CreateArtifactVersion(context.Background(), "my-group", "example-artifact", request, false)
+		assert.NoError(t, err)
+		assert.Equal(t, models.ContentTypeProtobuf, received.Content.ContentType)
+		// The caller's request is left untouched.
+		assert.Empty(t, request.Content.ContentType)
+	})
+
+	t.Run("ExplicitContentTypeIsKept", func(t *testing.T) {
+		request := &models.CreateVersionRequest{
+			ArtifactType: models.OpenAPI,
+			Content:      models.CreateContentRequest{Content: "openapi: 3.0.0\n", ContentType: "application/yaml"},
+		}
+		_, err := api.CreateArtifactVersion(context.Background(), "my-group", "example-artifact", request, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "application/yaml", received.Content.ContentType)
+	})
+}
+
+func TestVersionsAPI_GetArtifactVersionContent(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockResponse := `{"a": "1"}`
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/groups/my-group/artifacts/example-artifact/versions/1.0.0/content", r.URL.Path)
+			assert.Equal(t, http.MethodGet, r.Method)
+			// Write the response
+			w.Header().Set("X-Registry-ArtifactType", string(models.Json))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(mockResponse))
+			assert.NoError(t, err)
+
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		content, err := api.GetArtifactVersionContent(context.Background(), "my-group", "example-artifact", "1.0.0", nil)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, content)
+		assert.Equal(t, `{"a": "1"}`, content.Content)
+	})
+
+	t.Run("AcceptHeader", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "application/x-yaml", r.Header.Get("Accept"))
+			assert.Equal(t, "DEREFERENCE", r.URL.Query().Get("references"))
+			assert.Empty(t, r.URL.Query().Get("accept"))
+
+			w.Header().Set("Content-Type", "application/x-yaml")
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte("a: \"1\"\n"))
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		params := &models.ArtifactReferenceParams{HandleReferencesType: models.HandleReferencesTypeDereference, Accept: "application/x-yaml"}
+		content, err := api.GetArtifactVersionContent(context.Background(), "my-group", "example-artifact", "1.0.0", params)
+		assert.NoError(t, err)
+		assert.Equal(t, "a: \"1\"\n", content.Content)
+		assert.Equal(t, "application/x-yaml", content.ContentType)
+	})
+
+	t.Run("Gzip Encoded", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "gzip", r.Header.Get("Accept-Encoding"))
+
+			w.Header().Set("X-Registry-ArtifactType", string(models.Json))
+			w.Header().Set("Content-Encoding", "gzip")
+			w.WriteHeader(http.StatusOK)
+			gz := gzip.NewWriter(w)
+			_, err := gz.Write([]byte(`{"a": "1"}`))
+			assert.NoError(t, err)
+			assert.NoError(t, gz.Close())
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client(), Headers: http.Header{"Accept-Encoding": {"gzip"}}}
+		api := apis.NewVersionsAPI(mockClient)
+
+		content, err := api.GetArtifactVersionContent(context.Background(), "my-group", "example-artifact", "1.0.0", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"a": "1"}`, content.Content)
+	})
+
+	t.Run("Gzip Encoded Error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Encoding", "gzip")
+			w.WriteHeader(http.StatusNotFound)
+			gz := gzip.NewWriter(w)
+			assert.NoError(t, json.NewEncoder(gz).Encode(models.APIError{Status: 404, Title: "not found"}))
+			assert.NoError(t, gz.Close())
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client(), Headers: http.Header{"Accept-Encoding": {"gzip"}}}
+		api := apis.NewVersionsAPI(mockClient)
+
+		_, err := api.GetArtifactVersionContent(context.Background(), "my-group", "example-artifact", "1.0.0", nil)
+		assert.True(t, models.IsNotFound(err))
+	})
+
+	t.Run("Non-Conforming Error Body", func(t *testing.T) {
+		const page = "<html><body>502 Bad Gateway</body></html>"
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte(page))
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		_, err := api.GetArtifactVersionContent(context.Background(), "my-group", "example-artifact", "1.0.0", nil)
+
+		var apiErr *models.APIError
+		ok := errors.As(err, &apiErr)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusBadGateway, apiErr.Status)
+		assert.Equal(t, "Bad Gateway", apiErr.Title)
+		assert.Equal(t, page, string(apiErr.Raw))
+	})
+
+	t.Run("Too Large", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(strings.Repeat("a", 2048)))
+		}))
+		defer server.Close()
+
+		mockClient := client.NewClient(server.URL, client.WithHTTPClient(server.Client()), client.WithMaxResponseBodySize(1024))
+		api := apis.NewVersionsAPI(mockClient)
+
+		content, err := api.GetArtifactVersionContent(context.Background(), "my-group", "example-artifact", "1.0.0", nil)
+		assert.True(t, errors.Is(err, client.ErrResponseTooLarge))
+		assert.Nil(t, content)
+	})
+
+	t.Run("BadRequest", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			err := json.NewEncoder(w).Encode(models.APIError{Status: 400, Title: "bad request"})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		version, err := api.GetArtifactVersionContent(context.Background(), "my-group", "example-artifact", "1.0.0", nil)
+		assert.Error(t, err)
+		assert.Nil(t, version)
+
+		var apiErr *models.APIError
+		ok := errors.As(err, &apiErr)
+		assert.True(t, ok)
+		assert.Equal(t, 400, apiErr.Status)
+		assert.Equal(t, "bad request", apiErr.Title)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			err := json.NewEncoder(w).Encode(models.APIError{Status: 404, Title: "not found"})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
 
 		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
 		api := apis.NewVersionsAPI(mockClient)
@@ -1052,6 +2126,58 @@ func TestVersionsAPI_GetArtifactVersionContent(t *testing.T) {
 	})
 }
 
+func TestVersionsAPI_GetArtifactVersionContentWithRefsResolved(t *testing.T) {
+	dereferenced := `{"type": "object", "properties": {"customer": {"type": "object", "properties": {"id": {"type": "string"}}}}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/groups/my-group/artifacts/example-artifact/versions/1.0.0/content", r.URL.Path)
+		assert.Equal(t, "references=DEREFERENCE", r.URL.RawQuery)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(dereferenced))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	api := apis.NewVersionsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+	content, err := api.GetArtifactVersionContentWithRefsResolved(context.Background(), "my-group", "example-artifact", "1.0.0")
+	assert.NoError(t, err)
+	assert.Equal(t, dereferenced, content.Content)
+	assert.Equal(t, "application/json", content.ContentType)
+}
+
+func TestVersionsAPI_DiffVersions(t *testing.T) {
+	contents := map[string]string{
+		"1": `{"type": "object", "properties": {"id": {"type": "integer"}}}`,
+		"2": `{"type": "object", "properties": {"id": {"type": "integer"}, "email": {"type": "string"}}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		switch {
+		case r.URL.Path == "/groups/my-group/artifacts/example-artifact":
+			err := json.NewEncoder(w).Encode(models.ArtifactMetadata{BaseMetadata: models.BaseMetadata{ArtifactType: string(models.Json)}})
+			assert.NoError(t, err)
+		case strings.HasSuffix(r.URL.Path, "/content"):
+			version := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/groups/my-group/artifacts/example-artifact/versions/"), "/content")
+			_, err := w.Write([]byte(contents[version]))
+			assert.NoError(t, err)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	api := apis.NewVersionsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+
+	diff, err := api.DiffVersions(context.Background(), "my-group", "example-artifact", "1", "2")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"email"}, diff.Added)
+	assert.Empty(t, diff.Removed)
+	assert.Empty(t, diff.Changed)
+
+	_, err = api.DiffVersions(context.Background(), "my-group", "example-artifact", "1", "not a version")
+	assert.True(t, errors.Is(err, apis.ErrInvalidArgument))
+}
+
 func TestVersionsAPI_UpdateArtifactVersionContent(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1073,6 +2199,78 @@ func TestVersionsAPI_UpdateArtifactVersionContent(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("EmptyContentTypeIsInferred", func(t *testing.T) {
+		for _, tc := range []struct {
+			artifactType string
+			content      string
+			want         string
+		}{
+			{string(models.OpenAPI), "openapi: 3.0.0", models.ContentTypeYAML},
+			{string(models.Protobuf), `syntax = "proto3";`, models.ContentTypeProtobuf},
+			{string(models.Json), `{"a": "1"}`, models.ContentTypeJSON},
+			{"", `{"a": "1"}`, ""},
+		} {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					assert.Equal(t, "/groups/my-group/artifacts/example-artifact", r.URL.Path)
+					w.WriteHeader(http.StatusOK)
+					err := json.NewEncoder(w).Encode(models.ArtifactMetadata{BaseMetadata: models.BaseMetadata{ArtifactType: tc.artifactType}})
+					assert.NoError(t, err)
+					return
+				}
+				var received models.CreateContentRequest
+				err := json.NewDecoder(r.Body).Decode(&received)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, received.ContentType, tc.artifactType)
+				w.WriteHeader(http.StatusNoContent)
+			}))
+
+			mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+			api := apis.NewVersionsAPI(mockClient)
+
+			err := api.UpdateArtifactVersionContent(context.Background(), "my-group", "example-artifact", "1.0.0", &models.CreateContentRequest{Content: tc.content})
+			assert.NoError(t, err)
+			server.Close()
+		}
+	})
+
+	t.Run("References", func(t *testing.T) {
+		references := []models.ArtifactReference{
+			{GroupID: "my-group", ArtifactID: "address", Version: "2", Name: "address.json"},
+		}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var received map[string]json.RawMessage
+			err := json.NewDecoder(r.Body).Decode(&received)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `[{"groupId": "my-group", "artifactId": "address", "version": "2", "name": "address.json"}]`, string(received["references"]))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		content := models.ContentWithReferences(`{"$ref": "address.json"}`, references)
+		content.ContentType = models.ContentTypeJSON
+		err := api.UpdateArtifactVersionContent(context.Background(), "my-group", "example-artifact", "1.0.0", &content)
+		assert.NoError(t, err)
+	})
+
+	t.Run("References Without Content", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		err := api.UpdateArtifactVersionContent(context.Background(), "my-group", "example-artifact", "1.0.0", &models.CreateContentRequest{
+			References: []models.ArtifactReference{{GroupID: "my-group", ArtifactID: "address", Version: "2", Name: "address.json"}},
+		})
+		assert.ErrorIs(t, err, apis.ErrInvalidArgument)
+	})
+
 	t.Run("BadRequest", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			assert.Equal(t, "/groups/my-group/artifacts/example-artifact/versions/1.0.0/content", r.URL.Path)
@@ -1181,6 +2379,49 @@ func TestVersionsAPI_UpdateArtifactVersionContent(t *testing.T) {
 	})
 }
 
+func TestVersionsAPI_UpdateArtifactVersionContentType(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			base := "/groups/my-group/artifacts/example-artifact/versions/1.0.0"
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == base+"/content":
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(stubContent))
+				assert.NoError(t, err)
+			case r.Method == http.MethodGet && r.URL.Path == base+"/references":
+				assert.Equal(t, "OUTBOUND", r.URL.Query().Get("refType"))
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte("[" + stubReference + "]"))
+				assert.NoError(t, err)
+			case r.Method == http.MethodPut && r.URL.Path == base+"/content":
+				var received models.CreateContentRequest
+				err := json.NewDecoder(r.Body).Decode(&received)
+				assert.NoError(t, err)
+				assert.Equal(t, "application/x-yaml", received.ContentType)
+				assert.Equal(t, stubContent, received.Content)
+				assert.Len(t, received.References, 1)
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		err := api.UpdateArtifactVersionContentType(context.Background(), "my-group", "example-artifact", "1.0.0", "application/x-yaml")
+		assert.NoError(t, err)
+	})
+
+	t.Run("EmptyContentType", func(t *testing.T) {
+		api := apis.NewVersionsAPI(&client.Client{})
+
+		err := api.UpdateArtifactVersionContentType(context.Background(), "my-group", "example-artifact", "1.0.0", "")
+		assert.True(t, errors.Is(err, apis.ErrInvalidArgument))
+	})
+}
+
 func TestVersionsAPI_SearchForArtifactVersions(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockResponse := models.ArtifactVersionListResponse{
@@ -1264,6 +2505,25 @@ func TestVersionsAPI_SearchForArtifactVersions(t *testing.T) {
 		assert.Equal(t, 500, apiErr.Status)
 		assert.Equal(t, "Internal server error", apiErr.Title)
 	})
+
+	t.Run("InvalidFilters", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request to %s", r.URL)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		for _, params := range []*models.SearchVersionParams{
+			{State: models.State("RETIRED")},
+			{ArtifactType: models.ArtifactType("YAML")},
+		} {
+			versions, err := api.SearchForArtifactVersions(context.Background(), params)
+			assert.ErrorIs(t, err, apis.ErrInvalidArgument)
+			assert.Nil(t, versions)
+		}
+	})
 }
 
 func TestVersionsAPI_SearchForArtifactVersionByContent(t *testing.T) {
@@ -1297,14 +2557,39 @@ func TestVersionsAPI_SearchForArtifactVersionByContent(t *testing.T) {
 		}
 
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			assert.Equal(t, "/search/versions", r.URL.Path)
-			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/search/versions", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, "test-content", string(body))
+			assert.Equal(t, "*/*", r.Header.Get("Content-Type"))
+
+			w.WriteHeader(http.StatusOK)
+			err = json.NewEncoder(w).Encode(mockResponse)
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		params := &models.SearchVersionByContentParams{Limit: 10, Offset: 0}
+		versions, err := api.SearchForArtifactVersionByContent(context.Background(), "test-content", "", params)
+		assert.NoError(t, err)
+		assert.NotNil(t, versions)
+		assert.Equal(t, 2, len(*versions))
+		assert.Equal(t, "2.0.0", (*versions)[0].Version)
+		assert.Equal(t, "1.0.0", (*versions)[1].Version)
+	})
+
+	t.Run("CanonicalizeLocally", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			body, err := io.ReadAll(r.Body)
 			assert.NoError(t, err)
-			assert.Equal(t, "test-content", string(body))
+			assert.Equal(t, `{"name":"TestRecord","type":"record","fields":[{"name":"field1","type":"string"}]}`, string(body))
 
 			w.WriteHeader(http.StatusOK)
-			err = json.NewEncoder(w).Encode(mockResponse)
+			err = json.NewEncoder(w).Encode(models.ArtifactVersionListResponse{})
 			assert.NoError(t, err)
 		}))
 		defer server.Close()
@@ -1312,13 +2597,28 @@ func TestVersionsAPI_SearchForArtifactVersionByContent(t *testing.T) {
 		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
 		api := apis.NewVersionsAPI(mockClient)
 
-		params := &models.SearchVersionByContentParams{Limit: 10, Offset: 0}
-		versions, err := api.SearchForArtifactVersionByContent(context.Background(), "test-content", params)
+		params := &models.SearchVersionByContentParams{ArtifactType: models.Avro, CanonicalizeLocally: true}
+		versions, err := api.SearchForArtifactVersionByContent(context.Background(), stubContent, models.ContentTypeJSON, params)
+		assert.NoError(t, err)
+		assert.NotNil(t, versions)
+	})
+
+	t.Run("Content Type", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.ArtifactVersionListResponse{})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		versions, err := api.SearchForArtifactVersionByContent(context.Background(), "syntax = \"proto3\";", "application/x-protobuf", nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, versions)
-		assert.Equal(t, 2, len(*versions))
-		assert.Equal(t, "2.0.0", (*versions)[0].Version)
-		assert.Equal(t, "1.0.0", (*versions)[1].Version)
 	})
 
 	t.Run("BadRequest - Empty Content", func(t *testing.T) {
@@ -1333,7 +2633,7 @@ func TestVersionsAPI_SearchForArtifactVersionByContent(t *testing.T) {
 		api := apis.NewVersionsAPI(mockClient)
 
 		params := &models.SearchVersionByContentParams{Limit: 10, Offset: 0}
-		versions, err := api.SearchForArtifactVersionByContent(context.Background(), "", params)
+		versions, err := api.SearchForArtifactVersionByContent(context.Background(), "", "", params)
 		assert.Error(t, err)
 		assert.Nil(t, versions)
 
@@ -1356,7 +2656,7 @@ func TestVersionsAPI_SearchForArtifactVersionByContent(t *testing.T) {
 		api := apis.NewVersionsAPI(mockClient)
 
 		params := &models.SearchVersionByContentParams{Limit: 10, Offset: 0}
-		versions, err := api.SearchForArtifactVersionByContent(context.Background(), "test-content", params)
+		versions, err := api.SearchForArtifactVersionByContent(context.Background(), "test-content", "", params)
 		assert.Error(t, err)
 		assert.Nil(t, versions)
 
@@ -1439,6 +2739,32 @@ func TestVersionsAPI_GetArtifactVersionState(t *testing.T) {
 	})
 }
 
+func TestVersionsAPI_GetArtifactVersionState_UnknownState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(models.StateResponse{State: "ARCHIVED"})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	t.Run("Lenient", func(t *testing.T) {
+		api := apis.NewVersionsAPI(&client.Client{BaseURL: server.URL, HTTPClient: server.Client()})
+
+		state, err := api.GetArtifactVersionState(context.Background(), "my-group", "example-artifact", "1.0.0")
+		assert.NoError(t, err)
+		assert.Equal(t, models.State("ARCHIVED"), *state)
+	})
+
+	t.Run("Strict", func(t *testing.T) {
+		mockClient := client.NewClient(server.URL, client.WithHTTPClient(server.Client()), client.WithStrictStates())
+		api := apis.NewVersionsAPI(mockClient)
+
+		state, err := api.GetArtifactVersionState(context.Background(), "my-group", "example-artifact", "1.0.0")
+		assert.ErrorIs(t, err, models.ErrUnknownState)
+		assert.Nil(t, state)
+	})
+}
+
 func TestVersionsAPI_UpdateArtifactVersionState(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1473,7 +2799,7 @@ func TestVersionsAPI_UpdateArtifactVersionState(t *testing.T) {
 		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
 		api := apis.NewVersionsAPI(mockClient)
 
-		err := api.UpdateArtifactVersionState(context.Background(), "my-group", "example-artifact", "1.0", "INVALID_STATE", false)
+		err := api.UpdateArtifactVersionState(context.Background(), "my-group", "example-artifact", "1.0", models.StateDraft, false)
 		assert.Error(t, err)
 
 		var apiErr *models.APIError
@@ -1526,7 +2852,385 @@ func TestVersionsAPI_UpdateArtifactVersionState(t *testing.T) {
 	})
 }
 
+func TestVersionsAPI_UpdateArtifactVersionState_Validation(t *testing.T) {
+	var updates int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(models.StateResponse{State: models.StateDisabled})
+			assert.NoError(t, err)
+		case http.MethodPut:
+			atomic.AddInt32(&updates, 1)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	t.Run("UnknownState", func(t *testing.T) {
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		err := api.UpdateArtifactVersionState(context.Background(), "my-group", "example-artifact", "1.0", "INVALID_STATE", false)
+		assert.True(t, errors.Is(err, apis.ErrInvalidArgument))
+		assert.Equal(t, int32(0), atomic.LoadInt32(&updates))
+	})
+
+	t.Run("AllowedTransition", func(t *testing.T) {
+		mockClient := client.NewClient(server.URL, client.WithHTTPClient(server.Client()), client.WithStateTransitionValidation())
+		api := apis.NewVersionsAPI(mockClient)
+
+		for _, state := range []models.State{models.StateEnabled, models.StateDeprecated, models.StateDisabled} {
+			err := api.UpdateArtifactVersionState(context.Background(), "my-group", "example-artifact", "1.0", state, false)
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, int32(3), atomic.LoadInt32(&updates))
+	})
+
+	t.Run("DisallowedTransition", func(t *testing.T) {
+		atomic.StoreInt32(&updates, 0)
+		mockClient := client.NewClient(server.URL, client.WithHTTPClient(server.Client()), client.WithStateTransitionValidation())
+		api := apis.NewVersionsAPI(mockClient)
+
+		err := api.UpdateArtifactVersionState(context.Background(), "my-group", "example-artifact", "1.0", models.StateDraft, false)
+		assert.True(t, errors.Is(err, apis.ErrInvalidArgument))
+		assert.Contains(t, err.Error(), "DISABLED -> DRAFT")
+		assert.Equal(t, int32(0), atomic.LoadInt32(&updates))
+	})
+}
+
+func TestVersionsAPI_ClearArtifactVersionState(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/groups/test-group/artifacts/artifact-1/versions/1.0.0/state", r.URL.Path)
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Empty(t, r.URL.RawQuery)
+
+			var body models.StateRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, models.StateEnabled, body.State)
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		err := api.ClearArtifactVersionState(context.Background(), "test-group", "artifact-1", "1.0.0")
+		assert.NoError(t, err)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			err := json.NewEncoder(w).Encode(models.APIError{Status: 404, Title: "Version not found"})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		err := api.ClearArtifactVersionState(context.Background(), "test-group", "artifact-1", "9.9.9")
+		assert.Error(t, err)
+		var apiErr *models.APIError
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, 404, apiErr.Status)
+	})
+}
+
+func TestVersionsAPI_FinalizeDraft(t *testing.T) {
+	newServer := func(t *testing.T, state models.State, updates *[]models.State) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/groups/test-group/artifacts/artifact-1/versions/1.0.0/state", r.URL.Path)
+			switch r.Method {
+			case http.MethodGet:
+				w.WriteHeader(http.StatusOK)
+				err := json.NewEncoder(w).Encode(models.StateResponse{State: state})
+				assert.NoError(t, err)
+			case http.MethodPut:
+				var body models.StateRequest
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				*updates = append(*updates, body.State)
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			}
+		}))
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		var updates []models.State
+		server := newServer(t, models.StateDraft, &updates)
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		err := api.FinalizeDraft(context.Background(), "test-group", "artifact-1", "1.0.0")
+		assert.NoError(t, err)
+		assert.Equal(t, []models.State{models.StateEnabled}, updates)
+	})
+
+	t.Run("Not A Draft", func(t *testing.T) {
+		var updates []models.State
+		server := newServer(t, models.StateEnabled, &updates)
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		err := api.FinalizeDraft(context.Background(), "test-group", "artifact-1", "1.0.0")
+		assert.ErrorIs(t, err, apis.ErrInvalidArgument)
+		assert.Contains(t, err.Error(), "ENABLED")
+		assert.Empty(t, updates)
+	})
+}
+
+func TestVersionsAPI_DryRunArtifactVersionState(t *testing.T) {
+	t.Run("Allowed", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "true", r.URL.Query().Get("dryRun"))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		result, err := api.DryRunArtifactVersionState(context.Background(), "my-group", "example-artifact", "1.0", models.StateDeprecated)
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Empty(t, result.Reasons)
+	})
+
+	t.Run("Blocked", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "true", r.URL.Query().Get("dryRun"))
+			w.WriteHeader(http.StatusConflict)
+			err := json.NewEncoder(w).Encode(models.APIError{Status: 409, Title: "Conflict", Detail: "Rule violation: incompatible schema"})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		result, err := api.DryRunArtifactVersionState(context.Background(), "my-group", "example-artifact", "1.0", models.StateEnabled)
+		assert.NoError(t, err)
+		assert.False(t, result.Allowed)
+		assert.Equal(t, []string{"Conflict", "Rule violation: incompatible schema"}, result.Reasons)
+	})
+
+	t.Run("ServerError", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			err := json.NewEncoder(w).Encode(models.APIError{Status: 500, Title: "Internal server error"})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		result, err := api.DryRunArtifactVersionState(context.Background(), "my-group", "example-artifact", "1.0", models.StateEnabled)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestVersionsAPI_PathEscaping(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/groups/com.example/artifacts/com/example/Foo/versions/branch=latest/comments/50%off", r.URL.Path)
+		assert.Equal(t, "/groups/com.example/artifacts/com%2Fexample%2FFoo/versions/branch=latest/comments/50%25off", r.URL.EscapedPath())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewVersionsAPI(mockClient)
+
+	err := api.DeleteArtifactVersionComment(context.Background(), "com.example", "com/example/Foo", models.LatestVersion().String(), "50%off")
+	assert.NoError(t, err)
+}
+
+func TestVersionsAPI_GetVersionContentByGlobalID(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockResponse := `{"a": "1"}`
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/ids/globalIds/42", r.URL.Path)
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "DEREFERENCE", r.URL.Query().Get("references"))
+
+			w.Header().Set("X-Registry-ArtifactType", string(models.Json))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(mockResponse))
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		params := &models.ArtifactReferenceParams{HandleReferencesType: models.HandleReferencesTypeDereference}
+		content, err := api.GetVersionContentByGlobalID(context.Background(), 42, params)
+		assert.NoError(t, err)
+		assert.NotNil(t, content)
+		assert.Equal(t, mockResponse, content.Content)
+		assert.Equal(t, models.Json, content.ArtifactType)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			err := json.NewEncoder(w).Encode(models.APIError{Status: 404, Title: "not found"})
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		content, err := api.GetVersionContentByGlobalID(context.Background(), 42, nil)
+		assert.Error(t, err)
+		assert.Nil(t, content)
+		assert.True(t, errors.Is(err, apis.ErrArtifactNotFound))
+	})
+
+	t.Run("Typed From Header", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/ids/globalIds/42", r.URL.Path)
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Empty(t, r.URL.RawQuery)
+
+			w.Header().Set("X-Registry-ArtifactType", string(models.Protobuf))
+			w.Header().Set("Content-Type", "application/x-protobuf")
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`syntax = "proto3";`))
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		content, err := api.GetVersionContentByGlobalID(context.Background(), 42, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, `syntax = "proto3";`, content.Content)
+		assert.Equal(t, models.Protobuf, content.ArtifactType)
+	})
+
+	t.Run("Lowercase Type Header", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Registry-ArtifactType", "json")
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`{}`))
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		content, err := api.GetVersionContentByGlobalID(context.Background(), 42, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, models.Json, content.ArtifactType)
+	})
+
+	t.Run("Invalid Type Header", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Registry-ArtifactType", "NOT_A_TYPE")
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`{}`))
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		content, err := api.GetVersionContentByGlobalID(context.Background(), 42, nil)
+		assert.ErrorIs(t, err, models.ErrUnknownArtifactType)
+		assert.Nil(t, content)
+	})
+
+	t.Run("NotFound Without Body", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+		api := apis.NewVersionsAPI(mockClient)
+
+		content, err := api.GetVersionContentByGlobalID(context.Background(), 42, nil)
+		assert.ErrorIs(t, err, apis.ErrArtifactNotFound)
+		assert.Nil(t, content)
+	})
+}
+
 /***********************/
+func TestVersionsAPI_VersionExpressions(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(models.StateResponse{State: models.StateEnabled})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewVersionsAPI(mockClient)
+
+	t.Run("ValidExpressions", func(t *testing.T) {
+		for _, expr := range []models.VersionExpression{
+			models.LatestVersion(),
+			models.SpecificVersion("1.0.0+build.1"),
+			models.BranchVersion("release-2.x"),
+		} {
+			_, err := api.GetArtifactVersionState(context.Background(), "my-group", "example-artifact", expr.String())
+			assert.NoError(t, err)
+			assert.Equal(t, "/groups/my-group/artifacts/example-artifact/versions/"+expr.String()+"/state", path)
+		}
+	})
+
+	t.Run("MalformedExpressions", func(t *testing.T) {
+		for _, expr := range []models.VersionExpression{
+			models.SpecificVersion(""),
+			models.SpecificVersion("1.0.0/../../admin"),
+			models.SpecificVersion("1.0 0"),
+			models.BranchVersion(""),
+			"tag=latest",
+		} {
+			_, err := api.GetArtifactVersionState(context.Background(), "my-group", "example-artifact", expr.String())
+			assert.True(t, errors.Is(err, apis.ErrInvalidArgument), expr)
+		}
+	})
+}
+
+func TestVersionsAPI_CommentIDValidation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL)
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	api := apis.NewVersionsAPI(mockClient)
+
+	for _, commentID := range []string{"", "two words", strings.Repeat("1", 129)} {
+		err := api.UpdateArtifactVersionComment(context.Background(), "my-group", "example-artifact", "1.0.0", commentID, "updated")
+		assert.ErrorIs(t, err, apis.ErrInvalidArgument)
+		assert.ErrorContains(t, err, "must be 1 to 128 characters without whitespace")
+
+		err = api.DeleteArtifactVersionComment(context.Background(), "my-group", "example-artifact", "1.0.0", commentID)
+		assert.ErrorIs(t, err, apis.ErrInvalidArgument)
+	}
+}
+
 /***** Integration *****/
 /***********************/
 
@@ -1577,7 +3281,7 @@ func TestVersionsAPIIntegration(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		params := &models.ListArtifactsInGroupParams{}
+		params := &models.ListVersionsParams{}
 		resp, err := versionsAPI.ListArtifactVersions(ctx, groupID, generatedArtifactID, params)
 		assert.NoError(t, err)
 		assert.GreaterOrEqual(t, len(*resp), 1)
@@ -1621,7 +3325,7 @@ func TestVersionsAPIIntegration(t *testing.T) {
 		assert.Equal(t, "Test comment", comment.Value)
 
 		// Get comments
-		comments, err := versionsAPI.GetArtifactVersionComments(ctx, groupID, generatedArtifactID, version)
+		comments, err := versionsAPI.GetArtifactVersionComments(ctx, groupID, generatedArtifactID, version, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, comments)
 	})
@@ -1,21 +1,107 @@
 package client
 
 import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"net/url"
+	"runtime/debug"
+	"strings"
 	"time"
 )
 
+const modulePath = "github.com/subzerobo/go-apicurio-sdk"
+
+var (
+	// ErrInvalidBaseURL is returned by NewClientE when the base URL is not an absolute http or https URL.
+	ErrInvalidBaseURL = errors.New("invalid base URL")
+
+	// ErrResponseTooLarge is returned when reading a response body longer than Client.MaxResponseBodySize.
+	ErrResponseTooLarge = errors.New("response body exceeds the maximum size")
+)
+
+// RegistryV3APIPath is the path of the Apicurio Registry v3 REST API, relative to the registry host.
+const RegistryV3APIPath = "/apis/registry/v3"
+
+// DefaultUserAgent is sent with every request unless WithUserAgent configures another one.
+// It carries the SDK module version when available, e.g. "go-apicurio-sdk/v1.2.3".
+var DefaultUserAgent = "go-apicurio-sdk/" + moduleVersion()
+
+// moduleVersion returns the version of the SDK module the binary was built with.
+func moduleVersion() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			if dep.Path == modulePath {
+				return dep.Version
+			}
+		}
+		if info.Main.Path == modulePath && info.Main.Version != "" && info.Main.Version != "(devel)" {
+			return info.Main.Version
+		}
+	}
+	return "devel"
+}
+
 // Client is a reusable HTTP client for the SDK.
 type Client struct {
 	BaseURL    string
+	APIPath    string // Appended to BaseURL when building request URLs, e.g. RegistryV3APIPath
 	HTTPClient *http.Client
+	Doer       Doer // Sends the requests when set, in place of HTTPClient
 	AuthHeader string
+	UserAgent  string
+
+	// Headers are added to every request that does not already set them, e.g. a tenant header required by a gateway.
+	Headers http.Header
+
+	// DefaultRequestTimeout bounds requests whose context has no deadline of its own. Zero disables it.
+	DefaultRequestTimeout time.Duration
+
+	// Metrics, when set, is notified of every request the client performs.
+	Metrics Metrics
+
+	// Clock, when set, replaces the system clock, e.g. to control time in tests.
+	Clock Clock
+
+	// RequestEditors are run in order on every request right before it is sent.
+	RequestEditors []RequestEditorFn
+
+	// ResponseInterceptors are run in order on every response the server returns.
+	ResponseInterceptors []ResponseInterceptorFn
+
+	// ValidateJSONSchemas enables a local JSON Schema check of JSON artifact content before it is uploaded.
+	ValidateJSONSchemas bool
+
+	// ValidateStateTransitions makes UpdateArtifactVersionState reject transitions the registry is known to refuse.
+	ValidateStateTransitions bool
+
+	// StrictStates makes GetArtifactVersionState fail on states unknown to the SDK instead of returning them as is.
+	StrictStates bool
+
+	// AllowUnknownArtifactTypes disables the local check that artifact types are one of models.ArtifactType.
+	AllowUnknownArtifactTypes bool
+
+	// StrictDecoding makes decoding a response fail when it has fields the SDK models do not know.
+	StrictDecoding bool
+
+	// MaxResponseBodySize bounds the number of bytes read from a response body. Zero disables it.
+	MaxResponseBodySize int64
 }
 
 // Option is a functional option for configuring the Client.
 type Option func(*Client)
 
+// RequestEditorFn mutates a request right before it is sent, e.g. to sign it. Returning an error aborts the request.
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// ResponseInterceptorFn inspects a response before it is handed back to the caller, e.g. to record
+// rate-limit or request ID headers. It receives a copy of the response without its body.
+type ResponseInterceptorFn func(resp *http.Response)
+
 // WithHTTPClient is an option for setting a custom http.Client.
 func WithHTTPClient(httpClient *http.Client) Option {
 	return func(c *Client) {
@@ -23,6 +109,14 @@ func WithHTTPClient(httpClient *http.Client) Option {
 	}
 }
 
+// WithAPIPath is an option for setting the registry API path appended to the base URL, so that
+// the base URL can be just the registry host (e.g. WithAPIPath(RegistryV3APIPath)).
+func WithAPIPath(path string) Option {
+	return func(c *Client) {
+		c.APIPath = path
+	}
+}
+
 // WithAuthHeader is an option for setting an authentication header.
 func WithAuthHeader(authHeader string) Option {
 	return func(c *Client) {
@@ -30,6 +124,111 @@ func WithAuthHeader(authHeader string) Option {
 	}
 }
 
+// WithBasicAuth is an option for authenticating with HTTP basic auth. It sets the same Authorization
+// header as WithAuthHeader, so whichever of the two is applied last wins.
+func WithBasicAuth(username, password string) Option {
+	return func(c *Client) {
+		c.AuthHeader = "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+	}
+}
+
+// WithUserAgent is an option for setting the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.UserAgent = userAgent
+	}
+}
+
+// WithHeaders is an option for sending static headers with every request. Headers set on an
+// individual request take precedence. Calling it more than once merges the headers.
+func WithHeaders(headers http.Header) Option {
+	return func(c *Client) {
+		if c.Headers == nil {
+			c.Headers = http.Header{}
+		}
+		for key, values := range headers {
+			for _, value := range values {
+				c.Headers.Add(key, value)
+			}
+		}
+	}
+}
+
+// WithDefaultRequestTimeout is an option for bounding each request by d when the caller's context has no
+// deadline. Unlike http.Client.Timeout it applies per call, so a caller can still pass a longer deadline
+// for slow operations, or opt out entirely with WithoutRequestTimeout.
+func WithDefaultRequestTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.DefaultRequestTimeout = d
+	}
+}
+
+// WithJSONSchemaValidation is an option for validating JSON Schema content locally before
+// CreateArtifact and CreateArtifactVersion upload it, failing without a network call when it is invalid.
+func WithJSONSchemaValidation() Option {
+	return func(c *Client) {
+		c.ValidateJSONSchemas = true
+	}
+}
+
+// WithStateTransitionValidation is an option for checking the current state of a version before
+// UpdateArtifactVersionState changes it, failing without an update when the transition is not allowed.
+func WithStateTransitionValidation() Option {
+	return func(c *Client) {
+		c.ValidateStateTransitions = true
+	}
+}
+
+// WithStrictStates is an option for failing with models.ErrUnknownState when the registry reports a
+// version state the SDK does not know, e.g. one added by a newer registry.
+func WithStrictStates() Option {
+	return func(c *Client) {
+		c.StrictStates = true
+	}
+}
+
+// WithAllowUnknownArtifactTypes is an option for sending artifact types the SDK does not know about,
+// for registries configured with custom artifact types.
+func WithAllowUnknownArtifactTypes() Option {
+	return func(c *Client) {
+		c.AllowUnknownArtifactTypes = true
+	}
+}
+
+// WithRequestEditor is an option for running fn on every request once the client has set its own headers,
+// with access to the final URL and body. Editors added by repeated calls run in the order they were added.
+func WithRequestEditor(fn RequestEditorFn) Option {
+	return func(c *Client) {
+		c.RequestEditors = append(c.RequestEditors, fn)
+	}
+}
+
+// WithResponseInterceptor is an option for running fn on every response the server returns, before its body is
+// read. fn sees the status and headers but gets an empty body, so it cannot consume or close the real one.
+// Interceptors added by repeated calls run in the order they were added.
+func WithResponseInterceptor(fn ResponseInterceptorFn) Option {
+	return func(c *Client) {
+		c.ResponseInterceptors = append(c.ResponseInterceptors, fn)
+	}
+}
+
+// WithStrictDecoding is an option for failing on response fields the SDK models do not know, e.g. to catch
+// changes of the registry API early when upgrading the registry. By default unknown fields are ignored.
+func WithStrictDecoding() Option {
+	return func(c *Client) {
+		c.StrictDecoding = true
+	}
+}
+
+// WithMaxResponseBodySize is an option for failing with ErrResponseTooLarge once more than n bytes of a
+// response body are read, e.g. to protect against a misconfigured endpoint returning an enormous body.
+// The limit applies to the body as received, before any decompression.
+func WithMaxResponseBodySize(n int64) Option {
+	return func(c *Client) {
+		c.MaxResponseBodySize = n
+	}
+}
+
 // defaultHTTPClient provides a preconfigured HTTP client for the SDK.
 func defaultHTTPClient() *http.Client {
 	return &http.Client{
@@ -50,6 +249,7 @@ func NewClient(baseURL string, options ...Option) *Client {
 	client := &Client{
 		BaseURL:    baseURL,
 		HTTPClient: defaultHTTPClient(),
+		UserAgent:  DefaultUserAgent,
 	}
 
 	// Apply functional options
@@ -60,11 +260,164 @@ func NewClient(baseURL string, options ...Option) *Client {
 	return client
 }
 
+// NewClientE is like NewClient but fails with ErrInvalidBaseURL when baseURL is malformed, e.g. it has
+// a misspelled scheme or no host, instead of failing on the first request.
+func NewClientE(baseURL string, options ...Option) (*Client, error) {
+	if err := validateBaseURL(baseURL); err != nil {
+		return nil, err
+	}
+	return NewClient(baseURL, options...), nil
+}
+
+func validateBaseURL(baseURL string) error {
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return fmt.Errorf("%w: %q: %v", ErrInvalidBaseURL, baseURL, err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("%w: %q: scheme must be http or https", ErrInvalidBaseURL, baseURL)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("%w: %q: missing host", ErrInvalidBaseURL, baseURL)
+	}
+	return nil
+}
+
+// URL builds a request URL from the base URL, the API path, and the given path segments,
+// e.g. c.URL("groups", groupID, "artifacts"). Segments are escaped as described in JoinURL.
+func (c *Client) URL(segments ...string) string {
+	base := c.BaseURL
+	if apiPath := strings.Trim(c.APIPath, "/"); apiPath != "" {
+		base = strings.TrimRight(base, "/") + "/" + apiPath
+	}
+	return JoinURL(base, segments...)
+}
+
+// JoinURL appends path segments to base, separating them with exactly one slash.
+// Each segment is escaped with url.PathEscape, so IDs containing characters such as "/", "%" or spaces
+// stay within their own segment.
+func JoinURL(base string, segments ...string) string {
+	var b strings.Builder
+	b.WriteString(strings.TrimRight(base, "/"))
+	for _, segment := range segments {
+		b.WriteByte('/')
+		b.WriteString(url.PathEscape(segment))
+	}
+	return b.String()
+}
+
 // Do perform an HTTP request with optional authentication.
 func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	for key, values := range c.Headers {
+		if len(req.Header.Values(key)) > 0 {
+			continue
+		}
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
 	if c.AuthHeader != "" {
 		req.Header.Set("Authorization", c.AuthHeader)
 	}
-	req.Header.Set("Content-Type", "application/json")
-	return c.HTTPClient.Do(req)
+	if req.Header.Get("User-Agent") == "" {
+		userAgent := c.UserAgent
+		if userAgent == "" {
+			userAgent = DefaultUserAgent
+		}
+		req.Header.Set("User-Agent", userAgent)
+	}
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for _, edit := range c.RequestEditors {
+		if err := edit(req.Context(), req); err != nil {
+			// The request never reaches the transport, which would otherwise close the body.
+			if req.Body != nil {
+				_ = req.Body.Close()
+			}
+			return nil, fmt.Errorf("request editor: %w", err)
+		}
+	}
+
+	start := c.Now()
+	resp, err := c.send(req)
+	if c.Metrics != nil {
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		c.Metrics.ObserveRequest(req.Method, req.URL.Path, status, c.Now().Sub(start))
+	}
+	if err == nil {
+		for _, intercept := range c.ResponseInterceptors {
+			intercepted := *resp
+			intercepted.Header = resp.Header.Clone()
+			intercepted.Body = http.NoBody
+			intercept(&intercepted)
+		}
+	}
+	if err == nil && c.MaxResponseBodySize > 0 {
+		resp.Body = &limitedBody{ReadCloser: resp.Body, remaining: c.MaxResponseBodySize}
+	}
+	return resp, err
+}
+
+// send executes the request, applying the default request timeout.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if _, hasDeadline := ctx.Deadline(); c.DefaultRequestTimeout <= 0 || hasDeadline || ctx.Value(noRequestTimeoutKey{}) != nil {
+		return c.doer().Do(req)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.DefaultRequestTimeout)
+	resp, err := c.doer().Do(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// The deadline must outlive Do, as the body is read afterwards.
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type noRequestTimeoutKey struct{}
+
+// WithoutRequestTimeout returns a context for which the client's default request timeout does not apply,
+// e.g. for streaming a large export.
+func WithoutRequestTimeout(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRequestTimeoutKey{}, true)
+}
+
+// cancelOnClose releases the request timeout once the response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// limitedBody fails with ErrResponseTooLarge once more than remaining bytes are read.
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, ErrResponseTooLarge
+	}
+	// Read one byte past the limit to tell a body of exactly the maximum size from a larger one.
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		return n - 1, ErrResponseTooLarge
+	}
+	return n, err
 }
@@ -1,8 +1,12 @@
 package client_test
 
 import (
+	"context"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,6 +22,25 @@ func TestNewClient_Defaults(t *testing.T) {
 	assert.Equal(t, 30*time.Second, c.HTTPClient.Timeout)
 }
 
+func TestNewClientE(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		for _, baseURL := range []string{"https://example.com", "http://localhost:8080/apis/registry/v3"} {
+			c, err := client.NewClientE(baseURL, client.WithUserAgent("my-app/1.0"))
+			assert.NoError(t, err)
+			assert.Equal(t, baseURL, c.BaseURL)
+			assert.Equal(t, "my-app/1.0", c.UserAgent)
+		}
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		for _, baseURL := range []string{"", "htttp://example.com", "example.com", "https://", "http://exa mple.com", "://example.com"} {
+			c, err := client.NewClientE(baseURL)
+			assert.ErrorIs(t, err, client.ErrInvalidBaseURL, baseURL)
+			assert.Nil(t, c)
+		}
+	})
+}
+
 func TestNewClient_WithCustomHTTPClient(t *testing.T) {
 	customHTTPClient := &http.Client{Timeout: 10 * time.Second}
 
@@ -35,6 +58,46 @@ func TestNewClient_WithAuthHeader(t *testing.T) {
 	assert.Equal(t, "Bearer test-token", c.AuthHeader)
 }
 
+func TestNewClient_WithJSONSchemaValidation(t *testing.T) {
+	c := client.NewClient("https://example.com")
+	assert.False(t, c.ValidateJSONSchemas)
+
+	c = client.NewClient("https://example.com", client.WithJSONSchemaValidation())
+	assert.True(t, c.ValidateJSONSchemas)
+}
+
+func TestNewClient_WithStateTransitionValidation(t *testing.T) {
+	c := client.NewClient("https://example.com")
+	assert.False(t, c.ValidateStateTransitions)
+
+	c = client.NewClient("https://example.com", client.WithStateTransitionValidation())
+	assert.True(t, c.ValidateStateTransitions)
+}
+
+func TestNewClient_WithAllowUnknownArtifactTypes(t *testing.T) {
+	c := client.NewClient("https://example.com")
+	assert.False(t, c.AllowUnknownArtifactTypes)
+
+	c = client.NewClient("https://example.com", client.WithAllowUnknownArtifactTypes())
+	assert.True(t, c.AllowUnknownArtifactTypes)
+}
+
+func TestNewClient_WithStrictStates(t *testing.T) {
+	c := client.NewClient("https://example.com")
+	assert.False(t, c.StrictStates)
+
+	c = client.NewClient("https://example.com", client.WithStrictStates())
+	assert.True(t, c.StrictStates)
+}
+
+func TestNewClient_WithStrictDecoding(t *testing.T) {
+	c := client.NewClient("https://example.com")
+	assert.False(t, c.StrictDecoding)
+
+	c = client.NewClient("https://example.com", client.WithStrictDecoding())
+	assert.True(t, c.StrictDecoding)
+}
+
 func TestClient_Do_WithAuthHeader(t *testing.T) {
 	// Create a test HTTP server
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -55,6 +118,183 @@ func TestClient_Do_WithAuthHeader(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestClient_Do_WithBasicAuth(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Basic YWxhZGRpbjpvcGVuc2VzYW1l", r.Header.Get("Authorization"))
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "aladdin", username)
+		assert.Equal(t, "opensesame", password)
+		w.WriteHeader(http.StatusOK)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	c := client.NewClient(server.URL, client.WithBasicAuth("aladdin", "opensesame"))
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+
+	resp, err := c.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewClient_AuthOptionsLastWins(t *testing.T) {
+	c := client.NewClient("https://example.com", client.WithAuthHeader("Bearer test-token"), client.WithBasicAuth("user", "pass"))
+	assert.Equal(t, "Basic dXNlcjpwYXNz", c.AuthHeader)
+
+	c = client.NewClient("https://example.com", client.WithBasicAuth("user", "pass"), client.WithAuthHeader("Bearer test-token"))
+	assert.Equal(t, "Bearer test-token", c.AuthHeader)
+}
+
+func TestClient_Do_WithHeaders(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "tenant-a", r.Header.Get("X-Tenant-ID"))
+		assert.Equal(t, []string{"override"}, r.Header.Values("X-Gateway-Route"))
+		w.WriteHeader(http.StatusOK)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	c := client.NewClient(server.URL, client.WithHeaders(http.Header{
+		"X-Tenant-ID":     {"tenant-a"},
+		"X-Gateway-Route": {"default"},
+	}))
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+	req.Header.Set("X-Gateway-Route", "override")
+
+	resp, err := c.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestClient_Do_WithRequestEditor(t *testing.T) {
+	t.Run("Editors Run In Order", func(t *testing.T) {
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "GET /groups/my-group", r.Header.Get("X-Signature"))
+			assert.Equal(t, []string{"first", "second"}, r.Header.Values("X-Editors"))
+			w.WriteHeader(http.StatusOK)
+		})
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := client.NewClient(server.URL,
+			client.WithRequestEditor(func(ctx context.Context, req *http.Request) error {
+				req.Header.Set("X-Signature", req.Method+" "+req.URL.Path)
+				req.Header.Add("X-Editors", "first")
+				return nil
+			}),
+			client.WithRequestEditor(func(ctx context.Context, req *http.Request) error {
+				req.Header.Add("X-Editors", "second")
+				return nil
+			}),
+		)
+
+		req, err := http.NewRequest(http.MethodGet, c.URL("groups", "my-group"), nil)
+		assert.NoError(t, err)
+
+		resp, err := c.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Editor Error", func(t *testing.T) {
+		var called bool
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		defer server.Close()
+
+		errSigning := errors.New("signing key unavailable")
+		c := client.NewClient(server.URL, client.WithRequestEditor(func(ctx context.Context, req *http.Request) error {
+			return errSigning
+		}))
+
+		body := &closeRecorder{Reader: strings.NewReader("{}")}
+		req, err := http.NewRequest(http.MethodPost, server.URL, body)
+		assert.NoError(t, err)
+
+		resp, err := c.Do(req)
+		assert.ErrorIs(t, err, errSigning)
+		assert.Nil(t, resp)
+		assert.False(t, called)
+		assert.True(t, body.closed)
+	})
+}
+
+// closeRecorder is a request body that records whether it was closed.
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestClient_Do_WithResponseInterceptor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "41")
+		_, _ = w.Write([]byte("payload"))
+	}))
+	defer server.Close()
+
+	var remaining []string
+	c := client.NewClient(server.URL,
+		client.WithResponseInterceptor(func(resp *http.Response) {
+			remaining = append(remaining, resp.Header.Get("X-RateLimit-Remaining"))
+			_, _ = io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+		}),
+		client.WithResponseInterceptor(func(resp *http.Response) {
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			remaining = append(remaining, resp.Header.Get("X-RateLimit-Remaining"))
+		}),
+	)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+
+	resp, err := c.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, []string{"41", "41"}, remaining)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "payload", string(body))
+}
+
+func TestClient_Do_MaxResponseBodySize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(strings.Repeat("x", 10)))
+	}))
+	defer server.Close()
+
+	read := func(limit int64) (string, error) {
+		c := client.NewClient(server.URL, client.WithMaxResponseBodySize(limit))
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		assert.NoError(t, err)
+		resp, err := c.Do(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		return string(body), err
+	}
+
+	body, err := read(10)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("x", 10), body)
+
+	body, err = read(4)
+	assert.ErrorIs(t, err, client.ErrResponseTooLarge)
+	assert.Equal(t, "xxxx", body)
+}
+
 func TestClient_Do_WithoutAuthHeader(t *testing.T) {
 	// Create a test HTTP server
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -74,3 +314,165 @@ func TestClient_Do_WithoutAuthHeader(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+func TestClient_Do_KeepsContentType(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		w.WriteHeader(http.StatusOK)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	c := client.NewClient(server.URL)
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`syntax = "proto3";`))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-protobuf")
+
+	resp, err := c.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestClient_Do_UserAgent(t *testing.T) {
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	t.Run("Default", func(t *testing.T) {
+		c := client.NewClient(server.URL)
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		assert.NoError(t, err)
+
+		_, err = c.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, client.DefaultUserAgent, userAgent)
+		assert.True(t, strings.HasPrefix(userAgent, "go-apicurio-sdk/"))
+	})
+
+	t.Run("Custom", func(t *testing.T) {
+		c := client.NewClient(server.URL, client.WithUserAgent("schema-importer/2.1"))
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		assert.NoError(t, err)
+
+		_, err = c.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, "schema-importer/2.1", userAgent)
+	})
+}
+
+func TestClient_URL(t *testing.T) {
+	tests := []struct {
+		name     string
+		baseURL  string
+		apiPath  string
+		expected string
+	}{
+		{"FullBaseURL", "http://localhost:8080/apis/registry/v3", "", "http://localhost:8080/apis/registry/v3/groups/g/artifacts"},
+		{"TrailingSlash", "http://localhost:8080/apis/registry/v3/", "", "http://localhost:8080/apis/registry/v3/groups/g/artifacts"},
+		{"HostWithAPIPath", "http://localhost:8080", client.RegistryV3APIPath, "http://localhost:8080/apis/registry/v3/groups/g/artifacts"},
+		{"SlashesEverywhere", "http://localhost:8080/", "/apis/registry/v3/", "http://localhost:8080/apis/registry/v3/groups/g/artifacts"},
+		{"APIPathWithoutSlashes", "http://localhost:8080", "apis/registry/v3", "http://localhost:8080/apis/registry/v3/groups/g/artifacts"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := client.NewClient(tt.baseURL, client.WithAPIPath(tt.apiPath))
+			assert.Equal(t, tt.expected, c.URL("groups", "g", "artifacts"))
+		})
+	}
+}
+
+func TestJoinURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     string
+		segments []string
+		expected string
+	}{
+		{"Simple", "http://localhost:8080/apis/registry/v3", []string{"groups", "g"}, "http://localhost:8080/apis/registry/v3/groups/g"},
+		{"TrailingSlash", "http://localhost:8080/apis/registry/v3/", []string{"groups", "g"}, "http://localhost:8080/apis/registry/v3/groups/g"},
+		{"NoSegments", "http://localhost:8080/", nil, "http://localhost:8080"},
+		{"Dots", "http://localhost:8080", []string{"artifacts", "com.example.User"}, "http://localhost:8080/artifacts/com.example.User"},
+		{"SpecialCharacters", "http://localhost:8080", []string{"artifacts", "my artifact%v1?"}, "http://localhost:8080/artifacts/my%20artifact%25v1%3F"},
+		{"Slash", "http://localhost:8080", []string{"artifacts", "com/example/Foo"}, "http://localhost:8080/artifacts/com%2Fexample%2FFoo"},
+		{"BranchExpression", "http://localhost:8080", []string{"versions", "branch=latest"}, "http://localhost:8080/versions/branch=latest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, client.JoinURL(tt.base, tt.segments...))
+		})
+	}
+}
+
+func TestClient_Do_DefaultRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(200 * time.Millisecond):
+			w.WriteHeader(http.StatusOK)
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, client.WithDefaultRequestTimeout(20*time.Millisecond))
+
+	t.Run("SlowRequestIsCancelled", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		assert.NoError(t, err)
+
+		start := time.Now()
+		resp, err := c.Do(req)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Nil(t, resp)
+		assert.Less(t, time.Since(start), 150*time.Millisecond)
+	})
+
+	t.Run("CallerDeadlineWins", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		assert.NoError(t, err)
+
+		resp, err := c.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NoError(t, resp.Body.Close())
+	})
+
+	t.Run("OptOut", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(client.WithoutRequestTimeout(context.Background()), http.MethodGet, server.URL, nil)
+		assert.NoError(t, err)
+
+		resp, err := c.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NoError(t, resp.Body.Close())
+	})
+}
+
+func TestClient_Do_DefaultRequestTimeout_BodyReadable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("payload"))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, client.WithDefaultRequestTimeout(time.Second))
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+
+	resp, err := c.Do(req)
+	assert.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "payload", string(body))
+	assert.NoError(t, resp.Body.Close())
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// Clock tells the time and waits. The Client and the APIs built on it read the time through it, so
+// that tests can drive time-dependent behavior, such as cache expiry, with WithClock instead of sleeping.
+type Clock interface {
+	Now() time.Time
+	// Sleep waits for d to pass and returns ctx.Err() if ctx is done first.
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+// WithClock is an option for reading the time from clock instead of the system clock.
+func WithClock(clock Clock) Option {
+	return func(c *Client) {
+		c.Clock = clock
+	}
+}
+
+// Now returns the current time according to the client's Clock.
+func (c *Client) Now() time.Time {
+	return c.clock().Now()
+}
+
+// Sleep waits for d according to the client's Clock and returns ctx.Err() if ctx is done first.
+func (c *Client) Sleep(ctx context.Context, d time.Duration) error {
+	return c.clock().Sleep(ctx, d)
+}
+
+// clock returns the Clock the client reads the time from.
+func (c *Client) clock() Clock {
+	if c.Clock != nil {
+		return c.Clock
+	}
+	return systemClock{}
+}
+
+// systemClock is the Clock used unless WithClock is set.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
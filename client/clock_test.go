@@ -0,0 +1,72 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/client"
+)
+
+// fakeClock advances by step on every call to Now and only pretends to sleep.
+type fakeClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	step  time.Duration
+	slept []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now
+	c.now = c.now.Add(c.step)
+	return now
+}
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.slept = append(c.slept, d)
+	c.now = c.now.Add(d)
+	return ctx.Err()
+}
+
+func TestWithClock(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	clock := &fakeClock{now: time.Date(2024, 12, 10, 8, 0, 0, 0, time.UTC), step: 250 * time.Millisecond}
+	metrics := &fakeMetrics{}
+	c := client.NewClient(server.URL, client.WithClock(clock), client.WithMetrics(metrics))
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+	resp, err := c.Do(req)
+	assert.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+
+	assert.Len(t, metrics.observations, 1)
+	assert.Equal(t, 250*time.Millisecond, metrics.observations[0].duration)
+
+	start := c.Now()
+	assert.NoError(t, c.Sleep(context.Background(), time.Hour))
+	assert.Equal(t, []time.Duration{time.Hour}, clock.slept)
+	assert.Equal(t, time.Hour+clock.step, c.Now().Sub(start))
+}
+
+func TestClient_Sleep(t *testing.T) {
+	c := client.NewClient("https://example.com")
+
+	assert.NoError(t, c.Sleep(context.Background(), time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, c.Sleep(ctx, time.Hour), context.Canceled)
+}
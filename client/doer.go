@@ -0,0 +1,28 @@
+package client
+
+import "net/http"
+
+// Doer executes HTTP requests. *http.Client implements it; tests of code built on the SDK can
+// inject an in-memory implementation with WithDoer instead of running an HTTP server.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// WithDoer is an option for sending requests through d instead of the HTTP client.
+// Options that configure the HTTP client, such as WithTLSConfig, do not apply to d.
+func WithDoer(d Doer) Option {
+	return func(c *Client) {
+		c.Doer = d
+	}
+}
+
+// doer returns the Doer requests are sent through.
+func (c *Client) doer() Doer {
+	if c.Doer != nil {
+		return c.Doer
+	}
+	if c.HTTPClient == nil {
+		return http.DefaultClient
+	}
+	return c.HTTPClient
+}
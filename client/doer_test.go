@@ -0,0 +1,57 @@
+package client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/apis"
+	"github.com/subzerobo/go-apicurio-sdk/client"
+)
+
+// mockDoer answers requests from canned responses keyed by "METHOD path".
+type mockDoer struct {
+	responses map[string]string
+	requests  []*http.Request
+}
+
+func (m *mockDoer) Do(req *http.Request) (*http.Response, error) {
+	m.requests = append(m.requests, req)
+	body, ok := m.responses[req.Method+" "+req.URL.Path]
+	status := http.StatusOK
+	if !ok {
+		status, body = http.StatusNotFound, `{"status": 404, "title": "Not found"}`
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestWithDoer(t *testing.T) {
+	doer := &mockDoer{responses: map[string]string{
+		"GET /apis/registry/v3/search/artifacts": `{"count": 1, "artifacts": [{"groupId": "g", "artifactId": "a"}]}`,
+	}}
+	c := client.NewClient("http://registry.invalid",
+		client.WithAPIPath(client.RegistryV3APIPath),
+		client.WithAuthHeader("Bearer test-token"),
+		client.WithDoer(doer),
+	)
+	api := apis.NewArtifactsAPI(c)
+
+	artifacts, err := api.SearchArtifacts(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Len(t, *artifacts, 1)
+	assert.Equal(t, "a", (*artifacts)[0].ArtifactId)
+
+	_, err = api.GetArtifactContentByID(context.Background(), 1)
+	assert.Error(t, err)
+
+	assert.Len(t, doer.requests, 2)
+	assert.Equal(t, "Bearer test-token", doer.requests[0].Header.Get("Authorization"))
+}
@@ -0,0 +1,20 @@
+package client
+
+import "time"
+
+// Metrics receives an observation for every request performed by the Client.
+// Implement it with an adapter to record registry call rates, latencies and error ratios
+// in a monitoring system such as Prometheus or OpenTelemetry.
+type Metrics interface {
+	// ObserveRequest is called once the response headers are received or the request failed.
+	// The status is 0 when no response was received. The path contains resource IDs, so adapters
+	// should normalize it before using it as a label.
+	ObserveRequest(method, path string, status int, duration time.Duration)
+}
+
+// WithMetrics is an option for reporting every request to m.
+func WithMetrics(m Metrics) Option {
+	return func(c *Client) {
+		c.Metrics = m
+	}
+}
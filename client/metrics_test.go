@@ -0,0 +1,73 @@
+package client_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/client"
+)
+
+type observation struct {
+	method   string
+	path     string
+	status   int
+	duration time.Duration
+}
+
+type fakeMetrics struct {
+	mu           sync.Mutex
+	observations []observation
+}
+
+func (m *fakeMetrics) ObserveRequest(method, path string, status int, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.observations = append(m.observations, observation{method, path, status, duration})
+}
+
+func TestClient_Do_Metrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(10 * time.Millisecond)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	metrics := &fakeMetrics{}
+	c := client.NewClient(server.URL, client.WithMetrics(metrics))
+
+	for _, method := range []string{http.MethodGet, http.MethodDelete} {
+		req, err := http.NewRequest(method, server.URL+"/groups/g/artifacts/a", nil)
+		assert.NoError(t, err)
+		resp, err := c.Do(req)
+		assert.NoError(t, err)
+		assert.NoError(t, resp.Body.Close())
+	}
+
+	// A request that never reaches the server is reported with status 0.
+	server.Close()
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/system/info", nil)
+	assert.NoError(t, err)
+	_, err = c.Do(req)
+	assert.Error(t, err)
+
+	assert.Len(t, metrics.observations, 3)
+	assert.Equal(t, http.MethodGet, metrics.observations[0].method)
+	assert.Equal(t, "/groups/g/artifacts/a", metrics.observations[0].path)
+	assert.Equal(t, http.StatusOK, metrics.observations[0].status)
+	assert.Equal(t, http.MethodDelete, metrics.observations[1].method)
+	assert.Equal(t, http.StatusNotFound, metrics.observations[1].status)
+	assert.Equal(t, 0, metrics.observations[2].status)
+
+	for _, o := range metrics.observations[:2] {
+		assert.GreaterOrEqual(t, o.duration, 10*time.Millisecond)
+		assert.Less(t, o.duration, 5*time.Second)
+	}
+}
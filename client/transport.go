@@ -0,0 +1,86 @@
+package client
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// WithTLSConfig is an option for setting the TLS configuration of the client's transport, e.g. to
+// trust a private CA, present a client certificate for mTLS or raise the minimum TLS version.
+// The connection pool settings of the default transport are kept. When combined with WithHTTPClient,
+// apply it afterwards; it then configures a copy of that client and its *http.Transport, leaving the client
+// passed in unchanged, and has no effect on other RoundTrippers.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(c *Client) {
+		if transport := c.transport(); transport != nil {
+			transport.TLSClientConfig = config
+		}
+	}
+}
+
+// WithProxy is an option for routing requests through a proxy, e.g. WithProxy(http.ProxyURL(proxyURL)).
+// Like WithTLSConfig, it configures the client's *http.Transport and keeps its other settings.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) Option {
+	return func(c *Client) {
+		if transport := c.transport(); transport != nil {
+			transport.Proxy = proxy
+		}
+	}
+}
+
+// WithMaxIdleConnsPerHost is an option for setting how many idle connections to the registry are kept for
+// reuse, e.g. raising it for importers that send many concurrent requests. Like WithTLSConfig, it configures
+// the client's *http.Transport and keeps its other settings.
+func WithMaxIdleConnsPerHost(n int) Option {
+	return func(c *Client) {
+		if transport := c.transport(); transport != nil {
+			transport.MaxIdleConnsPerHost = n
+		}
+	}
+}
+
+// WithIdleConnTimeout is an option for setting how long an idle connection is kept before it is closed.
+// Like WithTLSConfig, it configures the client's *http.Transport and keeps its other settings.
+func WithIdleConnTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		if transport := c.transport(); transport != nil {
+			transport.IdleConnTimeout = d
+		}
+	}
+}
+
+// WithDisableKeepAlives is an option for opening a new connection for every request, e.g. behind a load
+// balancer that must spread requests over its backends. Like WithTLSConfig, it configures the client's
+// *http.Transport and keeps its other settings.
+func WithDisableKeepAlives(disable bool) Option {
+	return func(c *Client) {
+		if transport := c.transport(); transport != nil {
+			transport.DisableKeepAlives = disable
+		}
+	}
+}
+
+// transport returns a *http.Transport the options may configure, creating the defaults where they are missing.
+// The HTTP client and its transport may be shared, e.g. http.DefaultClient passed to WithHTTPClient, so both
+// are copied and the copy of the client is stored on c. It returns nil if the HTTP client uses a different
+// RoundTripper.
+func (c *Client) transport() *http.Transport {
+	if c.HTTPClient == nil {
+		c.HTTPClient = defaultHTTPClient()
+	}
+	var transport *http.Transport
+	switch rt := c.HTTPClient.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = rt.Clone()
+	default:
+		return nil
+	}
+	httpClient := *c.HTTPClient
+	httpClient.Transport = transport
+	c.HTTPClient = &httpClient
+	return transport
+}
@@ -0,0 +1,143 @@
+package client_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/client"
+)
+
+func TestWithTLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+
+	t.Run("CustomCA", func(t *testing.T) {
+		c := client.NewClient(server.URL, client.WithTLSConfig(&tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}))
+
+		transport, ok := c.HTTPClient.Transport.(*http.Transport)
+		assert.True(t, ok)
+		assert.Equal(t, 100, transport.MaxIdleConns)
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		assert.NoError(t, err)
+		resp, err := c.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		_ = resp.Body.Close()
+	})
+
+	t.Run("UntrustedCA", func(t *testing.T) {
+		c := client.NewClient(server.URL)
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		assert.NoError(t, err)
+		_, err = c.Do(req)
+		assert.Error(t, err)
+	})
+
+	t.Run("CustomHTTPClient", func(t *testing.T) {
+		c := client.NewClient(server.URL,
+			client.WithHTTPClient(&http.Client{}),
+			client.WithTLSConfig(&tls.Config{RootCAs: roots}),
+		)
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		assert.NoError(t, err)
+		resp, err := c.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		_ = resp.Body.Close()
+	})
+}
+
+func TestWithProxy(t *testing.T) {
+	var proxiedHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHost = r.Host
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	assert.NoError(t, err)
+
+	c := client.NewClient("http://registry.example.com", client.WithProxy(http.ProxyURL(proxyURL)))
+
+	req, err := http.NewRequest(http.MethodGet, c.URL("groups"), nil)
+	assert.NoError(t, err)
+	resp, err := c.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, "registry.example.com", proxiedHost)
+}
+
+func TestConnectionReuseOptions(t *testing.T) {
+	t.Run("Configured", func(t *testing.T) {
+		c := client.NewClient("https://example.com",
+			client.WithMaxIdleConnsPerHost(32),
+			client.WithIdleConnTimeout(15*time.Second),
+			client.WithDisableKeepAlives(true),
+		)
+
+		transport, ok := c.HTTPClient.Transport.(*http.Transport)
+		assert.True(t, ok)
+		assert.Equal(t, 32, transport.MaxIdleConnsPerHost)
+		assert.Equal(t, 15*time.Second, transport.IdleConnTimeout)
+		assert.True(t, transport.DisableKeepAlives)
+		assert.Equal(t, 100, transport.MaxIdleConns)
+	})
+
+	t.Run("Defaults", func(t *testing.T) {
+		c := client.NewClient("https://example.com")
+
+		transport, ok := c.HTTPClient.Transport.(*http.Transport)
+		assert.True(t, ok)
+		assert.Zero(t, transport.MaxIdleConnsPerHost)
+		assert.Equal(t, 90*time.Second, transport.IdleConnTimeout)
+		assert.False(t, transport.DisableKeepAlives)
+	})
+
+	t.Run("Custom HTTP Client", func(t *testing.T) {
+		httpClient := &http.Client{Timeout: 5 * time.Second}
+		c := client.NewClient("https://example.com", client.WithHTTPClient(httpClient), client.WithMaxIdleConnsPerHost(8))
+
+		transport, ok := c.HTTPClient.Transport.(*http.Transport)
+		assert.True(t, ok)
+		assert.Equal(t, 8, transport.MaxIdleConnsPerHost)
+		assert.Equal(t, 5*time.Second, c.HTTPClient.Timeout)
+		assert.Nil(t, httpClient.Transport)
+	})
+
+	t.Run("Shared HTTP Client", func(t *testing.T) {
+		sharedTransport := &http.Transport{MaxIdleConnsPerHost: 2}
+		shared := &http.Client{Transport: sharedTransport}
+		c := client.NewClient("https://example.com", client.WithHTTPClient(shared), client.WithMaxIdleConnsPerHost(8))
+
+		transport, ok := c.HTTPClient.Transport.(*http.Transport)
+		assert.True(t, ok)
+		assert.Equal(t, 8, transport.MaxIdleConnsPerHost)
+		assert.Same(t, sharedTransport, shared.Transport)
+		assert.Equal(t, 2, sharedTransport.MaxIdleConnsPerHost)
+	})
+
+	t.Run("Default HTTP Client", func(t *testing.T) {
+		defaultTransport := http.DefaultClient.Transport
+		c := client.NewClient("https://example.com", client.WithHTTPClient(http.DefaultClient), client.WithProxy(nil))
+
+		assert.NotSame(t, http.DefaultClient, c.HTTPClient)
+		assert.Equal(t, defaultTransport, http.DefaultClient.Transport)
+	})
+}
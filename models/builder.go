@@ -0,0 +1,84 @@
+package models
+
+import "fmt"
+
+// ArtifactBuilder builds a CreateArtifactRequest step by step.
+//
+//	req, err := models.NewArtifactBuilder(models.Avro).
+//		WithID("user").
+//		WithContent(schema, "").
+//		WithLabels(map[string]string{"team": "core"}).
+//		Build()
+type ArtifactBuilder struct {
+	request CreateArtifactRequest
+}
+
+// NewArtifactBuilder starts a CreateArtifactRequest for an artifact of the given type.
+func NewArtifactBuilder(artifactType ArtifactType) *ArtifactBuilder {
+	return &ArtifactBuilder{request: CreateArtifactRequest{ArtifactType: artifactType}}
+}
+
+// WithID sets the artifact ID. Without it the server generates one.
+func (b *ArtifactBuilder) WithID(artifactID string) *ArtifactBuilder {
+	b.request.ArtifactID = artifactID
+	return b
+}
+
+// WithName sets the name of the artifact.
+func (b *ArtifactBuilder) WithName(name string) *ArtifactBuilder {
+	b.request.Name = name
+	return b
+}
+
+// WithDescription sets the description of the artifact.
+func (b *ArtifactBuilder) WithDescription(description string) *ArtifactBuilder {
+	b.request.Description = description
+	return b
+}
+
+// WithVersion sets the version of the first artifact version.
+func (b *ArtifactBuilder) WithVersion(version string) *ArtifactBuilder {
+	b.request.FirstVersion.Version = version
+	return b
+}
+
+// WithContent sets the content of the first version.
+// An empty contentType is derived from the artifact type and the content (see DetectContentType).
+func (b *ArtifactBuilder) WithContent(content, contentType string) *ArtifactBuilder {
+	b.request.FirstVersion.Content.Content = content
+	b.request.FirstVersion.Content.ContentType = contentType
+	return b
+}
+
+// WithReferences sets the artifacts referenced by the content of the first version.
+func (b *ArtifactBuilder) WithReferences(references []ArtifactReference) *ArtifactBuilder {
+	b.request.FirstVersion.Content.References = references
+	return b
+}
+
+// WithLabels sets the labels of the artifact.
+func (b *ArtifactBuilder) WithLabels(labels map[string]string) *ArtifactBuilder {
+	b.request.Labels = labels
+	return b
+}
+
+// AsDraft creates the first version in the DRAFT state.
+func (b *ArtifactBuilder) AsDraft() *ArtifactBuilder {
+	b.request.FirstVersion.IsDraft = true
+	return b
+}
+
+// Build validates and returns the CreateArtifactRequest.
+func (b *ArtifactBuilder) Build() (CreateArtifactRequest, error) {
+	request := b.request
+	if _, err := ParseArtifactType(string(request.ArtifactType)); err != nil {
+		return CreateArtifactRequest{}, fmt.Errorf("%w: %q", ErrUnknownArtifactType, request.ArtifactType)
+	}
+	if request.FirstVersion.Content.Content == "" {
+		return CreateArtifactRequest{}, fmt.Errorf("artifact content is required")
+	}
+	if request.FirstVersion.Content.ContentType == "" {
+		request.FirstVersion.Content.ContentType = DetectContentType(request.ArtifactType, request.FirstVersion.Content.Content)
+	}
+	return request, nil
+}
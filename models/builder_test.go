@@ -0,0 +1,58 @@
+package models_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"testing"
+)
+
+func TestArtifactBuilder(t *testing.T) {
+	t.Run("Full", func(t *testing.T) {
+		refs := []models.ArtifactReference{{GroupID: "g", ArtifactID: "address", Version: "1", Name: "Address"}}
+		req, err := models.NewArtifactBuilder(models.Avro).
+			WithID("user").
+			WithName("User").
+			WithDescription("A user record").
+			WithVersion("1.0.0").
+			WithContent(`{"type":"string"}`, "").
+			WithReferences(refs).
+			WithLabels(map[string]string{"team": "core"}).
+			AsDraft().
+			Build()
+		assert.NoError(t, err)
+
+		expected := models.CreateArtifactRequest{
+			ArtifactID:   "user",
+			ArtifactType: models.Avro,
+			Name:         "User",
+			Description:  "A user record",
+			Labels:       map[string]string{"team": "core"},
+			FirstVersion: models.CreateVersionRequest{
+				Version: "1.0.0",
+				Content: models.CreateContentRequest{
+					Content:     `{"type":"string"}`,
+					References:  refs,
+					ContentType: models.ContentTypeJSON,
+				},
+				IsDraft: true,
+			},
+		}
+		assert.Equal(t, expected, req)
+	})
+
+	t.Run("Explicit Content Type", func(t *testing.T) {
+		req, err := models.NewArtifactBuilder(models.OpenAPI).WithContent("openapi: 3.0.0", models.ContentTypeYAML).Build()
+		assert.NoError(t, err)
+		assert.Equal(t, models.ContentTypeYAML, req.FirstVersion.Content.ContentType)
+	})
+
+	t.Run("Missing Content", func(t *testing.T) {
+		_, err := models.NewArtifactBuilder(models.Json).WithID("empty").Build()
+		assert.Error(t, err)
+	})
+
+	t.Run("Unknown Type", func(t *testing.T) {
+		_, err := models.NewArtifactBuilder("YAML").WithContent("a: b", "").Build()
+		assert.ErrorIs(t, err, models.ErrUnknownArtifactType)
+	})
+}
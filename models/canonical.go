@@ -0,0 +1,211 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// avroPrimitives are the Avro primitive type names.
+var avroPrimitives = map[string]bool{
+	"null":    true,
+	"boolean": true,
+	"int":     true,
+	"long":    true,
+	"float":   true,
+	"double":  true,
+	"bytes":   true,
+	"string":  true,
+}
+
+// Canonicalize returns a deterministic representation of content suitable for hashing or offline deduplication.
+//   - JSON-based types (JSON, OPENAPI, ASYNCAPI, KCONNECT) are re-encoded without whitespace and with object keys sorted.
+//   - AVRO schemas are reduced to the Avro "Parsing Canonical Form": names are fully qualified, attributes that
+//     do not affect parsing (doc, aliases, defaults, ...) are stripped, and the remaining attributes are ordered.
+//
+// Other artifact types return ErrCanonicalizationUnsupported.
+func Canonicalize(artifactType ArtifactType, content []byte) ([]byte, error) {
+	switch artifactType {
+	case Json, OpenAPI, AsyncAPI, KConnect, Avro:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrCanonicalizationUnsupported, artifactType)
+	}
+
+	node, err := decodeJSONDocument(content)
+	if err != nil {
+		return nil, err
+	}
+	if artifactType != Avro {
+		return canonicalJSON(node)
+	}
+
+	var buf bytes.Buffer
+	if err := writeAvroCanonical(&buf, node, ""); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeJSONDocument(content []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	var node interface{}
+	if err := decoder.Decode(&node); err != nil {
+		return nil, fmt.Errorf("failed to parse content as JSON: %w", err)
+	}
+	return node, nil
+}
+
+// canonicalJSON encodes node compactly; encoding/json already sorts map keys.
+func canonicalJSON(node interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(node); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// writeAvroCanonical writes the Parsing Canonical Form of an Avro schema node.
+func writeAvroCanonical(buf *bytes.Buffer, node interface{}, namespace string) error {
+	switch v := node.(type) {
+	case string:
+		if avroPrimitives[v] {
+			return writeJSONString(buf, v)
+		}
+		return writeJSONString(buf, avroFullName(v, namespace))
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, branch := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeAvroCanonical(buf, branch, namespace); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	case map[string]interface{}:
+		return writeAvroCanonicalObject(buf, v, namespace)
+	default:
+		return fmt.Errorf("invalid Avro schema: unexpected %T", node)
+	}
+}
+
+func writeAvroCanonicalObject(buf *bytes.Buffer, schema map[string]interface{}, namespace string) error {
+	typeName, ok := schema["type"].(string)
+	if !ok {
+		// e.g. {"type": {"type": "array", ...}}
+		if nested, exists := schema["type"]; exists {
+			return writeAvroCanonical(buf, nested, namespace)
+		}
+		return fmt.Errorf("invalid Avro schema: missing type")
+	}
+
+	switch typeName {
+	case "record", "error", "enum", "fixed":
+		name, ok := schema["name"].(string)
+		if !ok || name == "" {
+			return fmt.Errorf("invalid Avro schema: %s without a name", typeName)
+		}
+		if ns, ok := schema["namespace"].(string); ok && !strings.Contains(name, ".") {
+			namespace = ns
+		}
+		fullName := avroFullName(name, namespace)
+		if i := strings.LastIndex(fullName, "."); i >= 0 {
+			namespace = fullName[:i]
+		}
+
+		buf.WriteString(`{"name":`)
+		_ = writeJSONString(buf, fullName)
+		buf.WriteString(`,"type":`)
+		_ = writeJSONString(buf, typeName)
+
+		switch typeName {
+		case "record", "error":
+			fields, ok := schema["fields"].([]interface{})
+			if !ok {
+				return fmt.Errorf("invalid Avro schema: record %s without fields", fullName)
+			}
+			buf.WriteString(`,"fields":[`)
+			for i, f := range fields {
+				field, ok := f.(map[string]interface{})
+				if !ok {
+					return fmt.Errorf("invalid Avro schema: malformed field in record %s", fullName)
+				}
+				fieldName, ok := field["name"].(string)
+				if !ok {
+					return fmt.Errorf("invalid Avro schema: field without a name in record %s", fullName)
+				}
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				buf.WriteString(`{"name":`)
+				_ = writeJSONString(buf, fieldName)
+				buf.WriteString(`,"type":`)
+				if err := writeAvroCanonical(buf, field["type"], namespace); err != nil {
+					return err
+				}
+				buf.WriteByte('}')
+			}
+			buf.WriteByte(']')
+		case "enum":
+			symbols, ok := schema["symbols"].([]interface{})
+			if !ok {
+				return fmt.Errorf("invalid Avro schema: enum %s without symbols", fullName)
+			}
+			encoded, err := canonicalJSON(symbols)
+			if err != nil {
+				return err
+			}
+			buf.WriteString(`,"symbols":`)
+			buf.Write(encoded)
+		case "fixed":
+			size, ok := schema["size"].(json.Number)
+			if !ok {
+				return fmt.Errorf("invalid Avro schema: fixed %s without a size", fullName)
+			}
+			buf.WriteString(`,"size":`)
+			buf.WriteString(size.String())
+		}
+		buf.WriteByte('}')
+		return nil
+	case "array":
+		buf.WriteString(`{"type":"array","items":`)
+		if err := writeAvroCanonical(buf, schema["items"], namespace); err != nil {
+			return err
+		}
+		buf.WriteByte('}')
+		return nil
+	case "map":
+		buf.WriteString(`{"type":"map","values":`)
+		if err := writeAvroCanonical(buf, schema["values"], namespace); err != nil {
+			return err
+		}
+		buf.WriteByte('}')
+		return nil
+	default:
+		// Primitives in object form ({"type": "int"}) and references to named types.
+		return writeAvroCanonical(buf, typeName, namespace)
+	}
+}
+
+func avroFullName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func writeJSONString(buf *bytes.Buffer, s string) error {
+	encoded, err := canonicalJSON(s)
+	if err != nil {
+		return err
+	}
+	buf.Write(encoded)
+	return nil
+}
@@ -0,0 +1,70 @@
+package models_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"testing"
+)
+
+func TestCanonicalize_JSON(t *testing.T) {
+	first := `{"type": "object", "properties": {"b": {"type": "string"}, "a": {"type": "integer", "minimum": 1.5}}}`
+	second := `{
+		"properties": {
+			"a": {"minimum": 1.5, "type": "integer"},
+			"b": {"type": "string"}
+		},
+		"type": "object"
+	}`
+
+	canonicalFirst, err := models.Canonicalize(models.Json, []byte(first))
+	assert.NoError(t, err)
+	canonicalSecond, err := models.Canonicalize(models.Json, []byte(second))
+	assert.NoError(t, err)
+
+	assert.Equal(t, string(canonicalFirst), string(canonicalSecond))
+	assert.Equal(t, `{"properties":{"a":{"minimum":1.5,"type":"integer"},"b":{"type":"string"}},"type":"object"}`, string(canonicalFirst))
+}
+
+func TestCanonicalize_Avro(t *testing.T) {
+	schema := `{
+		"namespace": "com.example",
+		"type": "record",
+		"doc": "A user",
+		"name": "User",
+		"fields": [
+			{"name": "name", "type": {"type": "string"}, "doc": "full name"},
+			{"type": ["null", "Address"], "name": "address", "default": null},
+			{"name": "tags", "type": {"type": "array", "items": "string"}},
+			{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["ACTIVE", "INACTIVE"]}}
+		]
+	}`
+
+	canonical, err := models.Canonicalize(models.Avro, []byte(schema))
+	assert.NoError(t, err)
+	assert.Equal(t,
+		`{"name":"com.example.User","type":"record","fields":[`+
+			`{"name":"name","type":"string"},`+
+			`{"name":"address","type":["null","com.example.Address"]},`+
+			`{"name":"tags","type":{"type":"array","items":"string"}},`+
+			`{"name":"status","type":{"name":"com.example.Status","type":"enum","symbols":["ACTIVE","INACTIVE"]}}]}`,
+		string(canonical))
+
+	reordered := `{"fields":[{"type":"string","name":"name"},{"name":"address","type":["null","com.example.Address"]},` +
+		`{"name":"tags","type":{"items":"string","type":"array"}},` +
+		`{"name":"status","type":{"symbols":["ACTIVE","INACTIVE"],"type":"enum","name":"Status"}}],"name":"com.example.User","type":"record"}`
+	canonicalReordered, err := models.Canonicalize(models.Avro, []byte(reordered))
+	assert.NoError(t, err)
+	assert.Equal(t, string(canonical), string(canonicalReordered))
+}
+
+func TestCanonicalize_Errors(t *testing.T) {
+	t.Run("MalformedJSON", func(t *testing.T) {
+		_, err := models.Canonicalize(models.Json, []byte(`{"a":`))
+		assert.Error(t, err)
+	})
+
+	t.Run("UnsupportedType", func(t *testing.T) {
+		_, err := models.Canonicalize(models.Protobuf, []byte(`syntax = "proto3";`))
+		assert.ErrorIs(t, err, models.ErrCanonicalizationUnsupported)
+	})
+}
@@ -0,0 +1,47 @@
+package models
+
+import "strings"
+
+const (
+	ContentTypeJSON     = "application/json"
+	ContentTypeYAML     = "application/x-yaml"
+	ContentTypeXML      = "application/xml"
+	ContentTypeProtobuf = "application/x-protobuf"
+	ContentTypeGraphQL  = "application/graphql"
+)
+
+// DetectContentType infers the content type of an artifact's content from its artifact type.
+// OpenAPI and AsyncAPI documents may be written in JSON or YAML, so their content is inspected.
+// When the artifact type is empty or unknown, JSON and XML content is recognised by its first character;
+// otherwise an empty string is returned and the server is left to decide.
+func DetectContentType(artifactType ArtifactType, content string) string {
+	switch artifactType {
+	case Avro, Json, KConnect:
+		return ContentTypeJSON
+	case OpenAPI, AsyncAPI:
+		if looksLikeJSON(content) {
+			return ContentTypeJSON
+		}
+		return ContentTypeYAML
+	case Protobuf:
+		return ContentTypeProtobuf
+	case GraphQL:
+		return ContentTypeGraphQL
+	case WSDL, XSD:
+		return ContentTypeXML
+	}
+
+	switch {
+	case looksLikeJSON(content):
+		return ContentTypeJSON
+	case strings.HasPrefix(strings.TrimSpace(content), "<"):
+		return ContentTypeXML
+	default:
+		return ""
+	}
+}
+
+func looksLikeJSON(content string) bool {
+	trimmed := strings.TrimSpace(content)
+	return strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")
+}
@@ -0,0 +1,37 @@
+package models_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"testing"
+)
+
+func TestDetectContentType(t *testing.T) {
+	tests := []struct {
+		name         string
+		artifactType models.ArtifactType
+		content      string
+		expected     string
+	}{
+		{"Avro", models.Avro, `{"type":"string"}`, models.ContentTypeJSON},
+		{"Json", models.Json, `{"type":"object"}`, models.ContentTypeJSON},
+		{"KConnect", models.KConnect, `{"type":"struct"}`, models.ContentTypeJSON},
+		{"OpenAPI JSON", models.OpenAPI, ` {"openapi":"3.0.0"}`, models.ContentTypeJSON},
+		{"OpenAPI YAML", models.OpenAPI, "openapi: 3.0.0\n", models.ContentTypeYAML},
+		{"AsyncAPI JSON", models.AsyncAPI, `{"asyncapi":"2.6.0"}`, models.ContentTypeJSON},
+		{"AsyncAPI YAML", models.AsyncAPI, "asyncapi: 2.6.0\n", models.ContentTypeYAML},
+		{"Protobuf", models.Protobuf, `syntax = "proto3";`, models.ContentTypeProtobuf},
+		{"GraphQL", models.GraphQL, "type Query { id: ID }", models.ContentTypeGraphQL},
+		{"WSDL", models.WSDL, "<definitions/>", models.ContentTypeXML},
+		{"XSD", models.XSD, "<xs:schema/>", models.ContentTypeXML},
+		{"Unknown JSON", "", `[1, 2]`, models.ContentTypeJSON},
+		{"Unknown XML", "", "\n<root/>", models.ContentTypeXML},
+		{"Unknown Text", "", "plain text", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, models.DetectContentType(tt.artifactType, tt.content))
+		})
+	}
+}
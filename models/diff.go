@@ -0,0 +1,138 @@
+package models
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// SchemaDiff lists the fields that differ between two versions of a schema. Fields are identified by their
+// dotted path from the root, e.g. "address.street".
+type SchemaDiff struct {
+	Added   []string `json:"added"`   // Fields only present in the newer schema
+	Removed []string `json:"removed"` // Fields only present in the older schema
+	Changed []string `json:"changed"` // Fields present in both schemas with a different definition
+}
+
+// IsEmpty reports whether the two schemas have the same fields.
+func (d *SchemaDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffSchemas compares the fields of two schemas of the given artifact type.
+//   - JSON schemas are compared by their "properties", recursing into nested object properties.
+//   - AVRO schemas are compared by their record "fields", recursing into nested records.
+//
+// Other artifact types return ErrDiffUnsupported.
+func DiffSchemas(artifactType ArtifactType, from, to []byte) (*SchemaDiff, error) {
+	var fields func(node interface{}, prefix string, out map[string]interface{})
+	switch artifactType {
+	case Json:
+		fields = jsonSchemaFields
+	case Avro:
+		fields = avroSchemaFields
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrDiffUnsupported, artifactType)
+	}
+
+	fromNode, err := decodeJSONDocument(from)
+	if err != nil {
+		return nil, err
+	}
+	toNode, err := decodeJSONDocument(to)
+	if err != nil {
+		return nil, err
+	}
+
+	fromFields := map[string]interface{}{}
+	fields(fromNode, "", fromFields)
+	toFields := map[string]interface{}{}
+	fields(toNode, "", toFields)
+
+	diff := &SchemaDiff{}
+	for path, definition := range toFields {
+		previous, ok := fromFields[path]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, path)
+		case !reflect.DeepEqual(previous, definition):
+			diff.Changed = append(diff.Changed, path)
+		}
+	}
+	for path := range fromFields {
+		if _, ok := toFields[path]; !ok {
+			diff.Removed = append(diff.Removed, path)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Strings(diff.Changed)
+	return diff, nil
+}
+
+// jsonSchemaFields collects the properties of a JSON schema, keyed by path. Nested properties are collected
+// separately and left out of the definition of their parent, so a change is reported on the innermost field.
+func jsonSchemaFields(node interface{}, prefix string, out map[string]interface{}) {
+	schema, ok := node.(map[string]interface{})
+	if !ok {
+		return
+	}
+	properties, ok := schema["properties"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for name, property := range properties {
+		path := prefix + name
+		definition, ok := property.(map[string]interface{})
+		if !ok {
+			out[path] = property
+			continue
+		}
+		out[path] = withoutKey(definition, "properties")
+		jsonSchemaFields(definition, path+".", out)
+	}
+}
+
+// avroSchemaFields collects the fields of an Avro record, keyed by path. Fields of nested records are
+// collected separately and left out of the definition of their parent.
+func avroSchemaFields(node interface{}, prefix string, out map[string]interface{}) {
+	record, ok := node.(map[string]interface{})
+	if !ok {
+		return
+	}
+	fields, ok := record["fields"].([]interface{})
+	if !ok {
+		return
+	}
+	for _, f := range fields {
+		field, ok := f.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, ok := field["name"].(string)
+		if !ok {
+			continue
+		}
+		path := prefix + name
+		definition := field
+		if nested, ok := field["type"].(map[string]interface{}); ok {
+			if _, isRecord := nested["fields"]; isRecord {
+				definition = withoutKey(field, "type")
+				definition["type"] = withoutKey(nested, "fields")
+				avroSchemaFields(nested, path+".", out)
+			}
+		}
+		out[path] = definition
+	}
+}
+
+// withoutKey returns a shallow copy of m without key.
+func withoutKey(m map[string]interface{}, key string) map[string]interface{} {
+	copied := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if k != key {
+			copied[k] = v
+		}
+	}
+	return copied
+}
@@ -0,0 +1,61 @@
+package models_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"testing"
+)
+
+func TestDiffSchemas_JSON(t *testing.T) {
+	from := `{"type": "object", "properties": {
+		"id": {"type": "integer"},
+		"name": {"type": "string"},
+		"address": {"type": "object", "properties": {"street": {"type": "string"}, "zip": {"type": "string"}}}
+	}}`
+	to := `{"type": "object", "properties": {
+		"id": {"type": "string"},
+		"email": {"type": "string"},
+		"address": {"type": "object", "properties": {"street": {"type": "string"}, "city": {"type": "string"}}}
+	}}`
+
+	diff, err := models.DiffSchemas(models.Json, []byte(from), []byte(to))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"address.city", "email"}, diff.Added)
+	assert.Equal(t, []string{"address.zip", "name"}, diff.Removed)
+	assert.Equal(t, []string{"id"}, diff.Changed)
+	assert.False(t, diff.IsEmpty())
+}
+
+func TestDiffSchemas_Avro(t *testing.T) {
+	from := `{"type": "record", "name": "User", "fields": [
+		{"name": "id", "type": "long"},
+		{"name": "address", "type": {"type": "record", "name": "Address", "fields": [{"name": "street", "type": "string"}]}}
+	]}`
+	to := `{"type": "record", "name": "User", "fields": [
+		{"name": "id", "type": "long"},
+		{"name": "email", "type": ["null", "string"], "default": null},
+		{"name": "address", "type": {"type": "record", "name": "Address", "fields": [{"name": "street", "type": ["null", "string"]}]}}
+	]}`
+
+	diff, err := models.DiffSchemas(models.Avro, []byte(from), []byte(to))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"email"}, diff.Added)
+	assert.Empty(t, diff.Removed)
+	assert.Equal(t, []string{"address.street"}, diff.Changed)
+}
+
+func TestDiffSchemas_Identical(t *testing.T) {
+	schema := `{"type": "object", "properties": {"id": {"type": "integer"}}}`
+
+	diff, err := models.DiffSchemas(models.Json, []byte(schema), []byte(schema))
+	assert.NoError(t, err)
+	assert.True(t, diff.IsEmpty())
+}
+
+func TestDiffSchemas_Unsupported(t *testing.T) {
+	_, err := models.DiffSchemas(models.Protobuf, []byte(`syntax = "proto3";`), []byte(`syntax = "proto3";`))
+	assert.ErrorIs(t, err, models.ErrDiffUnsupported)
+
+	_, err = models.DiffSchemas(models.Json, []byte(`{`), []byte(`{}`))
+	assert.Error(t, err)
+}
@@ -1,9 +1,19 @@
 package models
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
 
 var (
 	ErrUnknownArtifactType = fmt.Errorf("unknown artifact type")
+	ErrUnknownState        = fmt.Errorf("unknown state")
+	ErrInvalidJSONSchema   = fmt.Errorf("invalid JSON schema")
+	ErrInvalidLabelFilter  = fmt.Errorf("invalid label filter")
+
+	ErrCanonicalizationUnsupported = fmt.Errorf("canonicalization is not supported for artifact type")
+	ErrDiffUnsupported             = fmt.Errorf("diff is not supported for artifact type")
 )
 
 // APIError represents the structure of an error response from the API.
@@ -14,6 +24,17 @@ type APIError struct {
 	Status   int    `json:"status"`   // The HTTP status code
 	Instance string `json:"instance"` // A URI reference identifying the specific occurrence
 	Name     string `json:"name"`     // The name of the error (e.g., server exception class name)
+
+	Causes []RuleViolationCause `json:"causes,omitempty"` // The rule violations, when a rule rejected the content
+
+	// Raw is the response body as received, for debugging error bodies that are not problem details.
+	Raw []byte `json:"-"`
+}
+
+// RuleViolationCause describes a single violation reported when content is rejected by a rule.
+type RuleViolationCause struct {
+	Description string `json:"description"` // What is wrong with the content
+	Context     string `json:"context"`     // Where in the content the violation occurs
 }
 
 // Error satisfies the error interface and formats the APIError as a string.
@@ -21,3 +42,39 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("[%d] %s: %s (detail: %s, instance: %s, type: %s)",
 		e.Status, e.Title, e.Name, e.Detail, e.Instance, e.Type)
 }
+
+// IsBadRequest reports whether err is or wraps an APIError with status 400.
+func IsBadRequest(err error) bool {
+	return hasStatus(err, http.StatusBadRequest)
+}
+
+// IsUnauthorized reports whether err is or wraps an APIError with status 401.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is or wraps an APIError with status 403.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsNotFound reports whether err is or wraps an APIError with status 404.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is or wraps an APIError with status 409.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsServerError reports whether err is or wraps an APIError with a 5xx status.
+func IsServerError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Status >= 500 && apiErr.Status <= 599
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Status == status
+}
@@ -0,0 +1,32 @@
+package models_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"testing"
+)
+
+func TestAPIErrorClassification(t *testing.T) {
+	tests := []struct {
+		status int
+		check  func(error) bool
+	}{
+		{400, models.IsBadRequest},
+		{401, models.IsUnauthorized},
+		{403, models.IsForbidden},
+		{404, models.IsNotFound},
+		{409, models.IsConflict},
+		{503, models.IsServerError},
+	}
+
+	for _, tt := range tests {
+		apiErr := &models.APIError{Status: tt.status}
+		assert.True(t, tt.check(apiErr), tt.status)
+		assert.True(t, tt.check(fmt.Errorf("lookup failed: %w", apiErr)), tt.status)
+		assert.False(t, tt.check(&models.APIError{Status: 418}), tt.status)
+		assert.False(t, tt.check(errors.New("connection refused")), tt.status)
+		assert.False(t, tt.check(nil), tt.status)
+	}
+}
@@ -1,5 +1,11 @@
 package models
 
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
 // IfExistsType represents the IfExists types for creating an artifact.
 type IfExistsType string
 
@@ -19,6 +25,45 @@ const (
 	StateDraft      State = "DRAFT"
 )
 
+// IsValid reports whether s is one of the states known to the registry.
+func (s State) IsValid() bool {
+	switch s {
+	case StateEnabled, StateDisabled, StateDeprecated, StateDraft:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsEnabled reports whether s is ENABLED.
+func (s State) IsEnabled() bool { return s == StateEnabled }
+
+// IsDisabled reports whether s is DISABLED.
+func (s State) IsDisabled() bool { return s == StateDisabled }
+
+// IsDeprecated reports whether s is DEPRECATED.
+func (s State) IsDeprecated() bool { return s == StateDeprecated }
+
+// IsDraft reports whether s is DRAFT.
+func (s State) IsDraft() bool { return s == StateDraft }
+
+// ParseState parses a string and returns the corresponding State.
+func ParseState(state string) (State, error) {
+	if s := State(state); s.IsValid() {
+		return s, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownState, state)
+}
+
+// CanTransitionTo reports whether a version in state s may be moved to next.
+// A version can only be a draft from the moment it is created, so no other state can go back to DRAFT.
+func (s State) CanTransitionTo(next State) bool {
+	if !s.IsValid() || !next.IsValid() {
+		return false
+	}
+	return next != StateDraft || s == StateDraft
+}
+
 // Order represents the order of the results.
 type Order string
 
@@ -31,13 +76,14 @@ const (
 type OrderBy string
 
 const (
-	OrderByGroupId    OrderBy = "groupId"
-	OrderByArtifactId OrderBy = "artifactId"
-	OrderByVersion    OrderBy = "version"
-	OrderByName       OrderBy = "name"
-	OrderByCreatedOn  OrderBy = "createdOn"
-	OrderByModifiedOn OrderBy = "modifiedOn"
-	OrderByGlobalId   OrderBy = "globalId"
+	OrderByGroupId      OrderBy = "groupId"
+	OrderByArtifactId   OrderBy = "artifactId"
+	OrderByArtifactType OrderBy = "artifactType"
+	OrderByVersion      OrderBy = "version"
+	OrderByName         OrderBy = "name"
+	OrderByCreatedOn    OrderBy = "createdOn"
+	OrderByModifiedOn   OrderBy = "modifiedOn"
+	OrderByGlobalId     OrderBy = "globalId"
 )
 
 // HandleReferencesType represents the type of handling references.
@@ -98,6 +144,50 @@ func ParseArtifactType(artifactType string) (ArtifactType, error) {
 	}
 }
 
+// ParseArtifactTypeFold is ParseArtifactType ignoring case, e.g. for "json" or "Json" in user input or
+// response headers.
+func ParseArtifactTypeFold(artifactType string) (ArtifactType, error) {
+	return ParseArtifactType(strings.ToUpper(artifactType))
+}
+
+// VersionExpression identifies an artifact version, either by its version string or through a branch.
+// Use the constructors rather than building expressions by hand, and pass String() wherever
+// the APIs take a versionExpression.
+type VersionExpression string
+
+// LatestVersion returns an expression for the latest version of an artifact.
+func LatestVersion() VersionExpression {
+	return BranchVersion("latest")
+}
+
+// SpecificVersion returns an expression for the given version. Use Validate to check that version is one
+// the registry accepts.
+func SpecificVersion(version string) VersionExpression {
+	return VersionExpression(version)
+}
+
+// BranchVersion returns an expression for the most recent version on the given branch. Use Validate to check
+// that branch is one the registry accepts.
+func BranchVersion(branch string) VersionExpression {
+	return VersionExpression("branch=" + branch)
+}
+
+// String returns the expression in the form accepted by the versions endpoints.
+func (e VersionExpression) String() string {
+	return string(e)
+}
+
+var regexVersionExpression = regexp.MustCompile(`^(branch=)?[a-zA-Z0-9._\-+]{1,256}$`)
+
+// Validate checks that the expression is a version or a branch expression the versions endpoints accept,
+// e.g. rejecting an empty version or branch, or one with a slash or a space.
+func (e VersionExpression) Validate() error {
+	if !regexVersionExpression.MatchString(string(e)) {
+		return fmt.Errorf("malformed version expression: %q", string(e))
+	}
+	return nil
+}
+
 type Rule string
 
 const (
@@ -106,6 +196,16 @@ const (
 	RuleIntegrity     Rule = "INTEGRITY"
 )
 
+// IsValid reports whether r is one of the rule types known to the registry.
+func (r Rule) IsValid() bool {
+	switch r {
+	case RuleValidity, RuleCompatibility, RuleIntegrity:
+		return true
+	default:
+		return false
+	}
+}
+
 // RuleLevel represents the level of different rules for VALIDITY, COMPATIBILITY, and INTEGRITY.
 type RuleLevel string
 
@@ -0,0 +1,111 @@
+package models_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"strings"
+	"testing"
+)
+
+func TestVersionExpression(t *testing.T) {
+	assert.Equal(t, "branch=latest", models.LatestVersion().String())
+	assert.Equal(t, "1.0.0", models.SpecificVersion("1.0.0").String())
+	assert.Equal(t, "branch=drafts", models.BranchVersion("drafts").String())
+}
+
+func TestVersionExpression_Validate(t *testing.T) {
+	for _, expr := range []models.VersionExpression{
+		models.LatestVersion(),
+		models.SpecificVersion("1.0.0+build.1"),
+		models.BranchVersion("release-2.x"),
+	} {
+		assert.NoError(t, expr.Validate(), expr)
+	}
+
+	for _, expr := range []models.VersionExpression{
+		models.SpecificVersion(""),
+		models.SpecificVersion("1.0.0/../../admin"),
+		models.SpecificVersion("1.0 0"),
+		models.SpecificVersion(strings.Repeat("1", 257)),
+		models.BranchVersion(""),
+		models.BranchVersion("feature/x"),
+		"tag=latest",
+	} {
+		assert.Error(t, expr.Validate(), expr)
+	}
+}
+
+func TestState_IsValid(t *testing.T) {
+	for _, state := range []models.State{models.StateEnabled, models.StateDisabled, models.StateDeprecated, models.StateDraft} {
+		assert.True(t, state.IsValid(), state)
+	}
+	assert.False(t, models.State("INVALID_STATE").IsValid())
+	assert.False(t, models.State("").IsValid())
+}
+
+func TestState_Is(t *testing.T) {
+	tests := []struct {
+		state                                models.State
+		enabled, disabled, deprecated, draft bool
+	}{
+		{models.StateEnabled, true, false, false, false},
+		{models.StateDisabled, false, true, false, false},
+		{models.StateDeprecated, false, false, true, false},
+		{models.StateDraft, false, false, false, true},
+		{models.State(""), false, false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			assert.Equal(t, tt.enabled, tt.state.IsEnabled())
+			assert.Equal(t, tt.disabled, tt.state.IsDisabled())
+			assert.Equal(t, tt.deprecated, tt.state.IsDeprecated())
+			assert.Equal(t, tt.draft, tt.state.IsDraft())
+		})
+	}
+}
+
+func TestState_CanTransitionTo(t *testing.T) {
+	assert.True(t, models.StateDraft.CanTransitionTo(models.StateEnabled))
+	assert.True(t, models.StateEnabled.CanTransitionTo(models.StateDeprecated))
+	assert.True(t, models.StateDisabled.CanTransitionTo(models.StateEnabled))
+	assert.False(t, models.StateDisabled.CanTransitionTo(models.StateDraft))
+	assert.False(t, models.StateEnabled.CanTransitionTo(models.StateDraft))
+	assert.False(t, models.StateEnabled.CanTransitionTo("INVALID_STATE"))
+}
+
+func TestRule_IsValid(t *testing.T) {
+	for _, rule := range []models.Rule{models.RuleValidity, models.RuleCompatibility, models.RuleIntegrity} {
+		assert.True(t, rule.IsValid(), rule)
+	}
+	assert.False(t, models.Rule("SECURITY").IsValid())
+	assert.False(t, models.Rule("").IsValid())
+}
+
+func TestParseState(t *testing.T) {
+	for _, state := range []string{"ENABLED", "DISABLED", "DEPRECATED", "DRAFT"} {
+		parsed, err := models.ParseState(state)
+		assert.NoError(t, err)
+		assert.Equal(t, models.State(state), parsed)
+	}
+
+	_, err := models.ParseState("ARCHIVED")
+	assert.ErrorIs(t, err, models.ErrUnknownState)
+}
+
+func TestParseArtifactTypeFold(t *testing.T) {
+	for _, artifactType := range []string{"json", "Json", "JSON"} {
+		parsed, err := models.ParseArtifactTypeFold(artifactType)
+		assert.NoError(t, err)
+		assert.Equal(t, models.Json, parsed)
+	}
+
+	parsed, err := models.ParseArtifactTypeFold("kconnect")
+	assert.NoError(t, err)
+	assert.Equal(t, models.KConnect, parsed)
+
+	_, err = models.ParseArtifactTypeFold("yaml")
+	assert.ErrorIs(t, err, models.ErrUnknownArtifactType)
+
+	_, err = models.ParseArtifactType("json")
+	assert.ErrorIs(t, err, models.ErrUnknownArtifactType)
+}
@@ -0,0 +1,184 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// jsonSchemaTypes are the primitive types allowed by the JSON Schema "type" keyword.
+var jsonSchemaTypes = map[string]bool{
+	"null":    true,
+	"boolean": true,
+	"object":  true,
+	"array":   true,
+	"number":  true,
+	"integer": true,
+	"string":  true,
+}
+
+// ValidateJSONSchema performs a local, structural check that content is a JSON Schema document.
+// It verifies the content is well-formed JSON and that the common keywords have the shape the
+// specification requires (e.g. "properties" is an object of schemas and "required" is a list of strings).
+// It does not resolve "$ref"s or check the schema against a specific draft's meta-schema.
+func ValidateJSONSchema(content []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	var schema interface{}
+	if err := decoder.Decode(&schema); err != nil {
+		return fmt.Errorf("%w: malformed JSON: %v", ErrInvalidJSONSchema, err)
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return fmt.Errorf("%w: unexpected content after the schema document", ErrInvalidJSONSchema)
+	}
+
+	return validateJSONSchemaNode(schema, "#")
+}
+
+func validateJSONSchemaNode(node interface{}, path string) error {
+	if _, ok := node.(bool); ok {
+		return nil
+	}
+	schema, ok := node.(map[string]interface{})
+	if !ok {
+		return invalidJSONSchema(path, "a schema must be an object or a boolean")
+	}
+
+	keywords := make([]string, 0, len(schema))
+	for keyword := range schema {
+		keywords = append(keywords, keyword)
+	}
+	sort.Strings(keywords)
+
+	for _, keyword := range keywords {
+		value := schema[keyword]
+		keywordPath := path + "/" + keyword
+
+		var err error
+		switch keyword {
+		case "type":
+			err = validateJSONSchemaType(value, keywordPath)
+		case "properties", "patternProperties", "definitions", "$defs", "dependentSchemas":
+			err = validateJSONSchemaMap(value, keywordPath)
+		case "items":
+			if items, isArray := value.([]interface{}); isArray {
+				err = validateJSONSchemaList(items, keywordPath)
+			} else {
+				err = validateJSONSchemaNode(value, keywordPath)
+			}
+		case "additionalProperties", "additionalItems", "not", "if", "then", "else", "contains", "propertyNames":
+			err = validateJSONSchemaNode(value, keywordPath)
+		case "allOf", "anyOf", "oneOf", "prefixItems":
+			items, isArray := value.([]interface{})
+			if !isArray || len(items) == 0 {
+				err = invalidJSONSchema(keywordPath, "must be a non-empty array of schemas")
+			} else {
+				err = validateJSONSchemaList(items, keywordPath)
+			}
+		case "required":
+			err = validateJSONSchemaStrings(value, keywordPath)
+		case "enum":
+			if _, isArray := value.([]interface{}); !isArray {
+				err = invalidJSONSchema(keywordPath, "must be an array")
+			}
+		case "$schema", "$id", "$ref", "title", "description", "pattern", "format":
+			if _, isString := value.(string); !isString {
+				err = invalidJSONSchema(keywordPath, "must be a string")
+			}
+		case "minLength", "maxLength", "minItems", "maxItems", "minProperties", "maxProperties":
+			if number, isNumber := value.(json.Number); !isNumber || !isNonNegativeInteger(number) {
+				err = invalidJSONSchema(keywordPath, "must be a non-negative integer")
+			}
+		case "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "multipleOf":
+			if _, isNumber := value.(json.Number); !isNumber {
+				err = invalidJSONSchema(keywordPath, "must be a number")
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateJSONSchemaType(value interface{}, path string) error {
+	switch v := value.(type) {
+	case string:
+		if !jsonSchemaTypes[v] {
+			return invalidJSONSchema(path, fmt.Sprintf("unknown type %q", v))
+		}
+		return nil
+	case []interface{}:
+		if len(v) == 0 {
+			return invalidJSONSchema(path, "must not be an empty array")
+		}
+		for i, item := range v {
+			if err := validateJSONSchemaType(item, fmt.Sprintf("%s/%d", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return invalidJSONSchema(path, "must be a string or an array of strings")
+	}
+}
+
+func validateJSONSchemaMap(value interface{}, path string) error {
+	schemas, ok := value.(map[string]interface{})
+	if !ok {
+		return invalidJSONSchema(path, "must be an object whose values are schemas")
+	}
+
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := validateJSONSchemaNode(schemas[name], path+"/"+escapeJSONPointer(name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateJSONSchemaList(items []interface{}, path string) error {
+	for i, item := range items {
+		if err := validateJSONSchemaNode(item, fmt.Sprintf("%s/%d", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateJSONSchemaStrings(value interface{}, path string) error {
+	items, ok := value.([]interface{})
+	if !ok {
+		return invalidJSONSchema(path, "must be an array of strings")
+	}
+	for _, item := range items {
+		if _, isString := item.(string); !isString {
+			return invalidJSONSchema(path, "must be an array of strings")
+		}
+	}
+	return nil
+}
+
+func isNonNegativeInteger(number json.Number) bool {
+	n, err := number.Int64()
+	return err == nil && n >= 0
+}
+
+func escapeJSONPointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func invalidJSONSchema(path, reason string) error {
+	return fmt.Errorf("%w: %s: %s", ErrInvalidJSONSchema, path, reason)
+}
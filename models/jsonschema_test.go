@@ -0,0 +1,52 @@
+package models_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"testing"
+)
+
+func TestValidateJSONSchema(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		schema := `{
+			"$schema": "https://json-schema.org/draft/2020-12/schema",
+			"title": "User",
+			"type": "object",
+			"properties": {
+				"name": {"type": "string", "minLength": 1},
+				"age": {"type": ["integer", "null"], "minimum": 0},
+				"tags": {"type": "array", "items": {"type": "string"}}
+			},
+			"required": ["name"],
+			"additionalProperties": false
+		}`
+		assert.NoError(t, models.ValidateJSONSchema([]byte(schema)))
+	})
+
+	t.Run("BooleanSchema", func(t *testing.T) {
+		assert.NoError(t, models.ValidateJSONSchema([]byte(`true`)))
+	})
+
+	tests := []struct {
+		name    string
+		content string
+		message string
+	}{
+		{"MalformedJSON", `{"type": "object",}`, "malformed JSON"},
+		{"TrailingContent", `{"type": "object"} {}`, "unexpected content"},
+		{"NotAnObject", `"object"`, "must be an object or a boolean"},
+		{"UnknownType", `{"type": "strnig"}`, `#/type: unknown type "strnig"`},
+		{"PropertiesNotAnObject", `{"properties": ["name"]}`, "#/properties: must be an object"},
+		{"NestedUnknownType", `{"properties": {"name": {"type": "text"}}}`, `#/properties/name/type: unknown type "text"`},
+		{"RequiredNotStrings", `{"required": [1]}`, "#/required: must be an array of strings"},
+		{"NegativeMinLength", `{"minLength": -1}`, "#/minLength: must be a non-negative integer"},
+		{"EmptyAllOf", `{"allOf": []}`, "#/allOf: must be a non-empty array of schemas"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := models.ValidateJSONSchema([]byte(tt.content))
+			assert.ErrorIs(t, err, models.ErrInvalidJSONSchema)
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+}
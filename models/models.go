@@ -25,10 +25,30 @@ type SearchedArtifact struct {
 	ModifiedOn   string       `json:"modifiedOn"`
 }
 
+// SearchedGroup represents a group as listed by the registry.
+type SearchedGroup struct {
+	GroupId     string            `json:"groupId"`
+	Description string            `json:"description"`
+	Owner       string            `json:"owner"`
+	CreatedOn   string            `json:"createdOn"`
+	ModifiedBy  string            `json:"modifiedBy"`
+	ModifiedOn  string            `json:"modifiedOn"`
+	Labels      map[string]string `json:"labels"`
+}
+
+// GroupSummary represents a group together with the number of artifacts it contains.
+type GroupSummary struct {
+	SearchedGroup
+	ArtifactCount int `json:"artifactCount"`
+}
+
 // ArtifactContent represents the content of an artifact + the type of the artifact.
+// When fetched from the registry, Content is the raw response body and is never decoded, so ArtifactType
+// always comes from the X-Registry-ArtifactType response header, even if the content has an "artifactType" field.
 type ArtifactContent struct {
 	Content      string       `json:"content"`
 	ArtifactType ArtifactType `json:"artifactType"`
+	ContentType  string       `json:"contentType,omitempty"` // Media type the server returned the content in, when known
 }
 
 // ArtifactDetail represents the detailed information about an artifact.
@@ -44,6 +64,28 @@ type ArtifactDetail struct {
 	Labels      map[string]string `json:"labels"`
 }
 
+// ArtifactExport holds the full history of an artifact, as produced by ArtifactsAPI.ExportArtifact.
+type ArtifactExport struct {
+	Metadata ArtifactMetadata        `json:"metadata"`
+	Versions []ArtifactVersionExport `json:"versions"` // Oldest first
+}
+
+// ArtifactVersionExport holds a single version of an exported artifact.
+type ArtifactVersionExport struct {
+	Metadata    ArtifactVersionMetadata `json:"metadata"`
+	State       State                   `json:"state"`
+	Content     string                  `json:"content"`
+	ContentType string                  `json:"contentType"`
+	References  []ArtifactReference     `json:"references,omitempty"`
+}
+
+// BatchResult is the outcome of a single item of a batch operation.
+type BatchResult struct {
+	Index    int             // Position of the item in the batch input
+	Artifact *ArtifactDetail // Created artifact, nil when Err is set
+	Err      error           // Error returned for the item, if any
+}
+
 // BaseMetadata contains common fields shared by both artifact and artifact version metadata.
 type BaseMetadata struct {
 	GroupID      string            `json:"groupId"`
@@ -103,3 +145,16 @@ type ArtifactVersionDetailed struct {
 	Description     string            `json:"description,omitempty"` // Description of the artifact version
 	Labels          map[string]string `json:"labels,omitempty"`      // User-defined name-value pairs
 }
+
+// Branch represents a branch of an artifact, an ordered list of some of its versions.
+type Branch struct {
+	GroupID       string `json:"groupId,omitempty"`     // Artifact group ID
+	ArtifactID    string `json:"artifactId"`            // Artifact ID
+	BranchID      string `json:"branchId"`              // Branch ID, e.g. "latest"
+	Description   string `json:"description,omitempty"` // Description of the branch
+	SystemDefined bool   `json:"systemDefined"`         // Whether the branch is managed by the registry (e.g. "latest")
+	Owner         string `json:"owner,omitempty"`       // User who created the branch
+	CreatedOn     string `json:"createdOn,omitempty"`   // Creation timestamp
+	ModifiedBy    string `json:"modifiedBy,omitempty"`  // User who last modified the branch
+	ModifiedOn    string `json:"modifiedOn,omitempty"`  // Last modification timestamp
+}
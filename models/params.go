@@ -1,7 +1,9 @@
 package models
 
 import (
+	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -17,13 +19,25 @@ type SearchArtifactsParams struct {
 	Limit        int          // Default: 20
 	Order        Order        // Default: "asc", Enum: "asc", "desc"
 	OrderBy      OrderBy      // Field to sort by, e.g., "name", "createdOn"
-	Labels       []string     // Filter by one or more name/value labels
+	Labels       []string     // Filter by one or more name/value labels, comma-joined. Deprecated: use LabelFilters
 	Description  string       // Filter by description
 	GroupID      string       // Filter by artifact group
 	GlobalID     int64        // Filter by globalId
 	ContentID    int64        // Filter by contentId
 	ArtifactID   string       // Filter by artifactId
 	ArtifactType ArtifactType // Filter by artifact type (e.g., AVRO, JSON)
+	Owner        string       // Filter by artifact owner
+
+	LabelFilters map[string]string // Filter by label name/value pairs, sent as one "labels=name:value" parameter each; an empty value matches any artifact with the label
+
+	// GroupIDs searches several groups, one request per group, merging the results. It takes precedence over
+	// GroupID; Offset and Limit apply to each group.
+	GroupIDs []string
+}
+
+// Validate checks that the label filters of the SearchArtifactsParams can be sent to the server.
+func (p *SearchArtifactsParams) Validate() error {
+	return validateLabelFilters(p.LabelFilters)
 }
 
 // ToQuery converts the SearchArtifactsParams struct to URL query parameters.
@@ -48,6 +62,7 @@ func (p *SearchArtifactsParams) ToQuery() url.Values {
 	if len(p.Labels) > 0 {
 		query.Set("labels", strings.Join(p.Labels, ","))
 	}
+	addLabelFilters(query, p.LabelFilters)
 	if p.Description != "" {
 		query.Set("description", p.Description)
 	}
@@ -66,10 +81,52 @@ func (p *SearchArtifactsParams) ToQuery() url.Values {
 	if p.ArtifactType != "" {
 		query.Set("artifactType", string(p.ArtifactType))
 	}
+	if p.Owner != "" {
+		query.Set("owner", p.Owner)
+	}
 
 	return query
 }
 
+// addLabelFilters adds one "labels" parameter per filter, sorted by label name and formatted with EncodeLabelFilter.
+// Filters EncodeLabelFilter rejects are left out; validateLabelFilters reports them before the query is built.
+func addLabelFilters(query url.Values, filters map[string]string) {
+	names := make([]string, 0, len(filters))
+	for name := range filters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if filter, err := EncodeLabelFilter(name, filters[name]); err == nil {
+			query.Add("labels", filter)
+		}
+	}
+}
+
+// validateLabelFilters checks that every filter can be formatted with EncodeLabelFilter.
+func validateLabelFilters(filters map[string]string) error {
+	for name, value := range filters {
+		if _, err := EncodeLabelFilter(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EncodeLabelFilter formats a label filter as "key:value", or as just "key" to match on the presence of the
+// label when value is empty. The registry does not decode label filters and splits them at the first colon,
+// so the value may contain colons and commas, but a key that is empty or contains a colon cannot be expressed
+// and is rejected with ErrInvalidLabelFilter.
+func EncodeLabelFilter(key, value string) (string, error) {
+	if key == "" || strings.Contains(key, ":") {
+		return "", fmt.Errorf("%w: label name %q must be non-empty and must not contain ':'", ErrInvalidLabelFilter, key)
+	}
+	if value == "" {
+		return key, nil
+	}
+	return key + ":" + value, nil
+}
+
 // SearchArtifactsByContentParams represents the query parameters for the search by content API.
 type SearchArtifactsByContentParams struct {
 	Canonical    bool    // Canonicalize the content
@@ -79,6 +136,8 @@ type SearchArtifactsByContentParams struct {
 	Limit        int     // Number of artifacts to return
 	Order        Order   // Sort order (asc, desc)
 	OrderBy      OrderBy // Field to sort by
+
+	CanonicalizeLocally bool // Canonicalize the content client-side (see Canonicalize) before sending; requires ArtifactType
 }
 
 // ToQuery converts the SearchArtifactsByContentParams struct to query parameters.
@@ -132,7 +191,7 @@ func (p *CreateArtifactParams) ToQuery() url.Values {
 	return query
 }
 
-// ListArtifactReferencesByGlobalIDParams represents the optional parameters for listing references by global ID.
+// ListArtifactReferencesByGlobalIDParams represents the optional parameters for listing references by global or content ID.
 type ListArtifactReferencesByGlobalIDParams struct {
 	RefType RefType
 }
@@ -148,10 +207,36 @@ func (p *ListArtifactReferencesByGlobalIDParams) ToQuery() url.Values {
 
 // ListArtifactsInGroupParams represents the query parameters for listing artifacts in a group.
 type ListArtifactsInGroupParams struct {
-	Limit   int    // Number of artifacts to return (default: 20)
-	Offset  int    // Number of artifacts to skip (default: 0)
-	Order   string // Enum: "asc", "desc"
-	OrderBy string // Enum: "groupId", "artifactId", "createdOn", "modifiedOn", "artifactType", "name"
+	Limit   int     // Number of artifacts to return (default: 20)
+	Offset  int     // Number of artifacts to skip (default: 0)
+	Order   Order   // Enum: "asc", "desc"
+	OrderBy OrderBy // Enum: "groupId", "artifactId", "createdOn", "modifiedOn", "artifactType", "name"
+
+	// LabelFilters keeps only artifacts with the given label name/value pairs; an empty value matches any
+	// artifact with the label. The group listing cannot filter on labels, so setting it lists through the
+	// search endpoint instead.
+	LabelFilters map[string]string
+}
+
+// Validate checks that the ListArtifactsInGroupParams are acceptable to the server.
+func (p *ListArtifactsInGroupParams) Validate() error {
+	if p.Limit < 0 {
+		return fmt.Errorf("limit must not be negative: %d", p.Limit)
+	}
+	if p.Offset < 0 {
+		return fmt.Errorf("offset must not be negative: %d", p.Offset)
+	}
+	switch p.Order {
+	case "", OrderAsc, OrderDesc:
+	default:
+		return fmt.Errorf("unsupported order: %s", p.Order)
+	}
+	switch p.OrderBy {
+	case "", OrderByGroupId, OrderByArtifactId, OrderByCreatedOn, OrderByModifiedOn, OrderByArtifactType, OrderByName:
+	default:
+		return fmt.Errorf("unsupported orderby for artifacts: %s", p.OrderBy)
+	}
+	return validateLabelFilters(p.LabelFilters)
 }
 
 // ToQuery converts the ListArtifactsInGroupParams struct to query parameters.
@@ -164,14 +249,128 @@ func (p *ListArtifactsInGroupParams) ToQuery() url.Values {
 		query.Set("offset", strconv.Itoa(p.Offset))
 	}
 	if p.Order != "" {
-		query.Set("order", p.Order)
+		query.Set("order", string(p.Order))
 	}
 	if p.OrderBy != "" {
-		query.Set("orderby", p.OrderBy)
+		query.Set("orderby", string(p.OrderBy))
 	}
 	return query
 }
 
+// ListVersionsParams represents the query parameters for listing the versions of an artifact.
+type ListVersionsParams struct {
+	Limit   int     // Number of versions to return (default: 20)
+	Offset  int     // Number of versions to skip (default: 0)
+	Order   Order   // Enum: "asc", "desc"
+	OrderBy OrderBy // Enum: "groupId", "artifactId", "version", "name", "createdOn", "modifiedOn", "globalId"
+}
+
+// Validate checks that the ListVersionsParams are acceptable to the server.
+func (p *ListVersionsParams) Validate() error {
+	if p.Limit < 0 {
+		return fmt.Errorf("limit must not be negative: %d", p.Limit)
+	}
+	if p.Offset < 0 {
+		return fmt.Errorf("offset must not be negative: %d", p.Offset)
+	}
+	switch p.Order {
+	case "", OrderAsc, OrderDesc:
+	default:
+		return fmt.Errorf("unsupported order: %s", p.Order)
+	}
+	switch p.OrderBy {
+	case "", OrderByGroupId, OrderByArtifactId, OrderByVersion, OrderByName, OrderByCreatedOn, OrderByModifiedOn, OrderByGlobalId:
+	default:
+		return fmt.Errorf("unsupported orderby for versions: %s", p.OrderBy)
+	}
+	return nil
+}
+
+// ToQuery converts the ListVersionsParams struct to query parameters.
+func (p *ListVersionsParams) ToQuery() url.Values {
+	query := url.Values{}
+	if p.Limit != 0 {
+		query.Set("limit", strconv.Itoa(p.Limit))
+	}
+	if p.Offset != 0 {
+		query.Set("offset", strconv.Itoa(p.Offset))
+	}
+	if p.Order != "" {
+		query.Set("order", string(p.Order))
+	}
+	if p.OrderBy != "" {
+		query.Set("orderby", string(p.OrderBy))
+	}
+	return query
+}
+
+// ListGroupsParams represents the query parameters for listing groups.
+type ListGroupsParams struct {
+	Limit   int     // Number of groups to return (default: 20)
+	Offset  int     // Number of groups to skip (default: 0)
+	Order   Order   // Enum: "asc", "desc"
+	OrderBy OrderBy // Enum: "groupId", "createdOn", "modifiedOn"
+}
+
+// Validate checks that the ListGroupsParams are acceptable to the server.
+func (p *ListGroupsParams) Validate() error {
+	if p.Limit < 0 {
+		return fmt.Errorf("limit must not be negative: %d", p.Limit)
+	}
+	if p.Offset < 0 {
+		return fmt.Errorf("offset must not be negative: %d", p.Offset)
+	}
+	switch p.Order {
+	case "", OrderAsc, OrderDesc:
+	default:
+		return fmt.Errorf("unsupported order: %s", p.Order)
+	}
+	switch p.OrderBy {
+	case "", OrderByGroupId, OrderByCreatedOn, OrderByModifiedOn:
+	default:
+		return fmt.Errorf("unsupported orderby for groups: %s", p.OrderBy)
+	}
+	return nil
+}
+
+// ToQuery converts the ListGroupsParams struct to query parameters.
+func (p *ListGroupsParams) ToQuery() url.Values {
+	query := url.Values{}
+	if p.Limit != 0 {
+		query.Set("limit", strconv.Itoa(p.Limit))
+	}
+	if p.Offset != 0 {
+		query.Set("offset", strconv.Itoa(p.Offset))
+	}
+	if p.Order != "" {
+		query.Set("order", string(p.Order))
+	}
+	if p.OrderBy != "" {
+		query.Set("orderby", string(p.OrderBy))
+	}
+	return query
+}
+
+// CommentListParams represents the options for listing the comments of a version.
+// The registry returns comments unordered and unpaginated, so both options are applied client-side.
+type CommentListParams struct {
+	Order Order // Sort by creation time, Enum: "asc", "desc"
+	Limit int   // Maximum number of comments to return (default: all)
+}
+
+// Validate checks that the CommentListParams are well-formed.
+func (p *CommentListParams) Validate() error {
+	if p.Limit < 0 {
+		return fmt.Errorf("limit must not be negative: %d", p.Limit)
+	}
+	switch p.Order {
+	case "", OrderAsc, OrderDesc:
+	default:
+		return fmt.Errorf("unsupported order: %s", p.Order)
+	}
+	return nil
+}
+
 // ArtifactVersionReferencesParams represents the query parameters for GetArtifactVersionReferences.
 type ArtifactVersionReferencesParams struct {
 	RefType RefType // "INBOUND" or "OUTBOUND"
@@ -189,6 +388,8 @@ func (p *ArtifactVersionReferencesParams) ToQuery() url.Values {
 // ArtifactReferenceParams represents the query parameters for artifact references.
 type ArtifactReferenceParams struct {
 	HandleReferencesType HandleReferencesType
+
+	Accept string // Representation to request through the Accept header (e.g. "application/json"); not sent as a query parameter
 }
 
 // ToQuery converts the ArtifactReferenceParams into URL query parameters.
@@ -216,6 +417,21 @@ type SearchVersionParams struct {
 	Name         string
 	State        State
 	ArtifactType ArtifactType
+
+	LabelFilters map[string]string // Filter by label name/value pairs, sent as one "labels=name:value" parameter each; an empty value matches any version with the label
+}
+
+// Validate checks that the enum filters of the SearchVersionParams are known to the server.
+func (p *SearchVersionParams) Validate() error {
+	if p.State != "" && !p.State.IsValid() {
+		return fmt.Errorf("unsupported state: %s", p.State)
+	}
+	if p.ArtifactType != "" {
+		if _, err := ParseArtifactType(string(p.ArtifactType)); err != nil {
+			return fmt.Errorf("unsupported artifact type: %s", p.ArtifactType)
+		}
+	}
+	return validateLabelFilters(p.LabelFilters)
 }
 
 // ToQuery converts the SearchVersionParams into URL query parameters.
@@ -239,6 +455,7 @@ func (p *SearchVersionParams) ToQuery() url.Values {
 	if len(p.Labels) > 0 {
 		query.Set("labels", strings.Join(p.Labels, ","))
 	}
+	addLabelFilters(query, p.LabelFilters)
 	if p.Description != "" {
 		query.Set("description", p.Description)
 	}
@@ -276,6 +493,10 @@ type SearchVersionByContentParams struct {
 	OrderBy      OrderBy
 	GroupID      string
 	ArtifactID   string
+	GlobalID     int64
+	ContentID    int64
+
+	CanonicalizeLocally bool // Canonicalize the content client-side (see Canonicalize) before sending; requires ArtifactType
 }
 
 // ToQuery converts the SearchVersionByContentParams into URL query parameters.
@@ -305,5 +526,24 @@ func (p *SearchVersionByContentParams) ToQuery() url.Values {
 	if p.ArtifactID != "" {
 		query.Set("artifactId", p.ArtifactID)
 	}
+	if p.GlobalID > 0 {
+		query.Set("globalId", strconv.FormatInt(p.GlobalID, 10))
+	}
+	if p.ContentID > 0 {
+		query.Set("contentId", strconv.FormatInt(p.ContentID, 10))
+	}
 	return query
 }
+
+// ExportArtifactParams represents the options of ArtifactsAPI.ExportArtifact.
+type ExportArtifactParams struct {
+	MaxVersions int // Fail rather than export an artifact with more versions (default: 1000)
+}
+
+// Validate checks that the ExportArtifactParams are well-formed.
+func (p *ExportArtifactParams) Validate() error {
+	if p.MaxVersions < 0 {
+		return fmt.Errorf("max versions must not be negative: %d", p.MaxVersions)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package models_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"net/url"
+	"testing"
+)
+
+func TestSearchVersionByContentParams_ToQuery(t *testing.T) {
+	canonical := true
+	params := &models.SearchVersionByContentParams{
+		Canonical:    &canonical,
+		ArtifactType: models.Avro,
+		Offset:       10,
+		Limit:        5,
+		Order:        models.OrderDesc,
+		OrderBy:      models.OrderByGlobalId,
+		GroupID:      "my-group",
+		ArtifactID:   "my-artifact",
+		GlobalID:     42,
+		ContentID:    7,
+	}
+
+	expected := url.Values{
+		"canonical":    {"true"},
+		"artifactType": {"AVRO"},
+		"offset":       {"10"},
+		"limit":        {"5"},
+		"order":        {"desc"},
+		"orderby":      {"globalId"},
+		"groupId":      {"my-group"},
+		"artifactId":   {"my-artifact"},
+		"globalId":     {"42"},
+		"contentId":    {"7"},
+	}
+	assert.Equal(t, expected, params.ToQuery())
+	assert.Empty(t, (&models.SearchVersionByContentParams{}).ToQuery())
+}
+
+func TestSearchArtifactsParams_Owner(t *testing.T) {
+	params := &models.SearchArtifactsParams{Owner: "alice", ArtifactType: models.Avro}
+	assert.Equal(t, url.Values{"owner": {"alice"}, "artifactType": {"AVRO"}}, params.ToQuery())
+
+	_, ok := (&models.SearchArtifactsParams{ArtifactType: models.Avro}).ToQuery()["owner"]
+	assert.False(t, ok)
+}
+
+func TestLabelFilters_ToQuery(t *testing.T) {
+	filters := map[string]string{"env": "prod", "deprecated": "", "team": "a,b"}
+	expected := []string{"deprecated", "env:prod", "team:a,b"}
+
+	artifactQuery := (&models.SearchArtifactsParams{LabelFilters: filters}).ToQuery()
+	assert.Equal(t, expected, artifactQuery["labels"])
+	assert.Equal(t, "labels=deprecated&labels=env%3Aprod&labels=team%3Aa%2Cb", artifactQuery.Encode())
+
+	versionQuery := (&models.SearchVersionParams{LabelFilters: filters}).ToQuery()
+	assert.Equal(t, expected, versionQuery["labels"])
+}
+
+func TestEncodeLabelFilter(t *testing.T) {
+	for _, tc := range []struct{ key, value, want string }{
+		{"env", "prod", "env:prod"},
+		{"deprecated", "", "deprecated"},
+		{"team", "a,b", "team:a,b"},
+		{"endpoint", "http://host:8080/a,b", "endpoint:http://host:8080/a,b"},
+	} {
+		filter, err := models.EncodeLabelFilter(tc.key, tc.value)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.want, filter)
+	}
+
+	for _, key := range []string{"", "ns:env"} {
+		_, err := models.EncodeLabelFilter(key, "prod")
+		assert.ErrorIs(t, err, models.ErrInvalidLabelFilter, key)
+	}
+	assert.ErrorIs(t, (&models.SearchArtifactsParams{LabelFilters: map[string]string{"ns:env": "prod"}}).Validate(), models.ErrInvalidLabelFilter)
+	assert.ErrorIs(t, (&models.SearchVersionParams{LabelFilters: map[string]string{"ns:env": "prod"}}).Validate(), models.ErrInvalidLabelFilter)
+	assert.ErrorIs(t, (&models.ListArtifactsInGroupParams{LabelFilters: map[string]string{"ns:env": "prod"}}).Validate(), models.ErrInvalidLabelFilter)
+}
+
+func TestListArtifactsInGroupParams(t *testing.T) {
+	for _, orderBy := range []models.OrderBy{"", models.OrderByGroupId, models.OrderByArtifactId, models.OrderByCreatedOn, models.OrderByModifiedOn, models.OrderByArtifactType, models.OrderByName} {
+		params := &models.ListArtifactsInGroupParams{Order: models.OrderDesc, OrderBy: orderBy}
+		assert.NoError(t, params.Validate(), orderBy)
+	}
+
+	params := &models.ListArtifactsInGroupParams{Limit: 5, Order: models.OrderAsc, OrderBy: models.OrderByArtifactType}
+	assert.Equal(t, url.Values{"limit": {"5"}, "order": {"asc"}, "orderby": {"artifactType"}}, params.ToQuery())
+
+	assert.Error(t, (&models.ListArtifactsInGroupParams{OrderBy: models.OrderByVersion}).Validate())
+	assert.Error(t, (&models.ListArtifactsInGroupParams{OrderBy: "size"}).Validate())
+	assert.Error(t, (&models.ListArtifactsInGroupParams{Order: "up"}).Validate())
+	assert.Error(t, (&models.ListArtifactsInGroupParams{Limit: -1}).Validate())
+}
+
+func TestListGroupsParams(t *testing.T) {
+	t.Run("ToQuery", func(t *testing.T) {
+		params := &models.ListGroupsParams{Limit: 20, Offset: 40, Order: models.OrderAsc, OrderBy: models.OrderByCreatedOn}
+		assert.NoError(t, params.Validate())
+		assert.Equal(t, "limit=20&offset=40&order=asc&orderby=createdOn", params.ToQuery().Encode())
+		assert.Empty(t, (&models.ListGroupsParams{}).ToQuery().Encode())
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		for _, params := range []*models.ListGroupsParams{
+			{Limit: -1},
+			{Offset: -1},
+			{Order: "sideways"},
+			{OrderBy: models.OrderByArtifactId},
+			{OrderBy: models.OrderByVersion},
+		} {
+			assert.Error(t, params.Validate(), "%+v", params)
+		}
+		for _, orderBy := range []models.OrderBy{models.OrderByGroupId, models.OrderByCreatedOn, models.OrderByModifiedOn} {
+			assert.NoError(t, (&models.ListGroupsParams{OrderBy: orderBy}).Validate())
+		}
+	})
+}
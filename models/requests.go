@@ -23,6 +23,10 @@ type CreateVersionRequest struct {
 	Labels      map[string]string    `json:"labels,omitempty"`
 	Branches    []string             `json:"branches,omitempty"`
 	IsDraft     bool                 `json:"isDraft"`
+
+	// ArtifactType is the type of the artifact the version is added to. It is not sent to the
+	// server and only enables type-specific client-side checks (e.g. JSON Schema validation).
+	ArtifactType ArtifactType `json:"-"`
 }
 
 // CreateContentRequest represents the content of an artifact.
@@ -32,6 +36,19 @@ type CreateContentRequest struct {
 	ContentType string              `json:"contentType"`
 }
 
+// ContentWithReferences returns the content of a version that imports the given artifacts.
+// References must be registered along with the content for the INTEGRITY rule to resolve them.
+func ContentWithReferences(content string, refs []ArtifactReference) CreateContentRequest {
+	return CreateContentRequest{Content: content, References: refs}
+}
+
+// SearchContentRequest represents the structured body of a search-by-content request,
+// used when the content imports other artifacts.
+type SearchContentRequest struct {
+	Content    string              `json:"content"`
+	References []ArtifactReference `json:"references,omitempty"`
+}
+
 // UpdateArtifactMetadataRequest represents the metadata update request.
 type UpdateArtifactMetadataRequest struct {
 	Name        string            `json:"name,omitempty"`        // Editable name
@@ -16,9 +16,16 @@ type ListArtifactsResponse struct {
 	Count     int                `json:"count"`
 }
 
+// ListGroupsResponse represents the response from the list groups API.
+type ListGroupsResponse struct {
+	Groups []SearchedGroup `json:"groups"`
+	Count  int             `json:"count"`
+}
+
 // CreateArtifactResponse represents the response from the create artifact API.
 type CreateArtifactResponse struct {
-	Artifact ArtifactDetail `json:"artifact"`
+	Artifact ArtifactDetail          `json:"artifact"`
+	Version  ArtifactVersionDetailed `json:"version"`
 }
 
 // ArtifactVersionListResponse represents the response of GetArtifactVersions.
@@ -27,10 +34,37 @@ type ArtifactVersionListResponse struct {
 	Versions []ArtifactVersion `json:"versions"`
 }
 
+// BranchListResponse represents the response of listing the branches of an artifact.
+type BranchListResponse struct {
+	Count    int      `json:"count"`
+	Branches []Branch `json:"branches"`
+}
+
 type StateResponse struct {
 	State State `json:"state"`
 }
 
+// StateChangeResult describes the outcome of a dry-run state change.
+type StateChangeResult struct {
+	Allowed bool     // Whether the server would accept the state change
+	Reasons []string // Why the state change was blocked, as reported by the server
+}
+
+// CompatibilityResult describes whether content could be added as a new version under the artifact rules.
+type CompatibilityResult struct {
+	Compatible bool     // Whether the server would accept the content
+	Reasons    []string // Why the content was rejected, as reported by the server
+}
+
+// DryRunResult describes the outcome of a dry-run create. Nothing is persisted, so the artifact and its
+// version carry no GlobalID or ContentID.
+type DryRunResult struct {
+	Valid    bool                    // Whether the server would accept the artifact
+	Reasons  []string                // Why the artifact was rejected, as reported by the server
+	Artifact ArtifactDetail          // The artifact as it would be created, when Valid
+	Version  ArtifactVersionDetailed // The first version as it would be created, when Valid
+}
+
 type GlobalRuleResponse struct {
 	RuleType Rule      `json:"ruleType"`
 	Config   RuleLevel `json:"config"`
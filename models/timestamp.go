@@ -0,0 +1,58 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// timestampLayouts are the formats the registry uses for timestamps: RFC 3339, and the offset without a colon
+// (e.g. "2024-12-10T08:56:40+0000") sent by some registry versions.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+}
+
+// ParseTimestamp parses a timestamp as returned by the registry, e.g. "2024-12-10T08:56:40Z".
+func ParseTimestamp(value string) (time.Time, error) {
+	var err error
+	for _, layout := range timestampLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %q: %w", value, err)
+}
+
+// CreatedTime parses CreatedOn.
+func (a SearchedArtifact) CreatedTime() (time.Time, error) { return ParseTimestamp(a.CreatedOn) }
+
+// ModifiedTime parses ModifiedOn.
+func (a SearchedArtifact) ModifiedTime() (time.Time, error) { return ParseTimestamp(a.ModifiedOn) }
+
+// CreatedTime parses CreatedOn.
+func (a ArtifactDetail) CreatedTime() (time.Time, error) { return ParseTimestamp(a.CreatedOn) }
+
+// ModifiedTime parses ModifiedOn.
+func (a ArtifactDetail) ModifiedTime() (time.Time, error) { return ParseTimestamp(a.ModifiedOn) }
+
+// CreatedTime parses CreatedOn.
+func (m BaseMetadata) CreatedTime() (time.Time, error) { return ParseTimestamp(m.CreatedOn) }
+
+// ModifiedTime parses ModifiedOn.
+func (m ArtifactMetadata) ModifiedTime() (time.Time, error) { return ParseTimestamp(m.ModifiedOn) }
+
+// CreatedTime parses CreatedOn.
+func (c ArtifactComment) CreatedTime() (time.Time, error) { return ParseTimestamp(c.CreatedOn) }
+
+// CreatedTime parses CreatedOn.
+func (v ArtifactVersion) CreatedTime() (time.Time, error) { return ParseTimestamp(v.CreatedOn) }
+
+// ModifiedTime parses ModifiedOn.
+func (v ArtifactVersion) ModifiedTime() (time.Time, error) { return ParseTimestamp(v.ModifiedOn) }
+
+// CreatedTime parses CreatedOn.
+func (b Branch) CreatedTime() (time.Time, error) { return ParseTimestamp(b.CreatedOn) }
+
+// ModifiedTime parses ModifiedOn.
+func (b Branch) ModifiedTime() (time.Time, error) { return ParseTimestamp(b.ModifiedOn) }
@@ -0,0 +1,39 @@
+package models_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"testing"
+	"time"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	want := time.Date(2024, 12, 10, 8, 56, 40, 0, time.UTC)
+
+	for _, value := range []string{"2024-12-10T08:56:40Z", "2024-12-10T08:56:40+0000", "2024-12-10T09:56:40+01:00"} {
+		parsed, err := models.ParseTimestamp(value)
+		assert.NoError(t, err, value)
+		assert.True(t, want.Equal(parsed), value)
+	}
+
+	parsed, err := models.ParseTimestamp("2024-12-10T08:56:40.123Z")
+	assert.NoError(t, err)
+	assert.Equal(t, 123*time.Millisecond, parsed.Sub(want))
+
+	for _, value := range []string{"", "10/12/2024 08:56", "2024-12-10"} {
+		_, err := models.ParseTimestamp(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestArtifactVersion_Times(t *testing.T) {
+	version := models.ArtifactVersion{CreatedOn: "2024-12-10T08:56:40Z", ModifiedOn: "yesterday"}
+
+	created, err := version.CreatedTime()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 12, 10, 8, 56, 40, 0, time.UTC), created)
+	assert.Equal(t, "2024-12-10T08:56:40Z", version.CreatedOn)
+
+	_, err = version.ModifiedTime()
+	assert.ErrorContains(t, err, `invalid timestamp "yesterday"`)
+}
@@ -0,0 +1,185 @@
+// Package serde provides Kafka-style serializers and deserializers that resolve their schemas through the registry.
+//
+// Messages use the Apicurio wire format: a single magic byte (0x0), followed by the 8-byte big-endian
+// global ID of the writer schema, followed by the encoded payload.
+package serde
+
+import (
+	"context"
+	"encoding/binary"
+	"github.com/pkg/errors"
+	"github.com/subzerobo/go-apicurio-sdk/apis"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"sync"
+)
+
+// MagicByte is the first byte of every message produced by the serializers in this package.
+const MagicByte byte = 0x0
+
+const headerSize = 1 + 8
+
+var (
+	ErrInvalidMagicByte = errors.New("invalid magic byte")
+	ErrMessageTooShort  = errors.New("message is too short to contain a schema header")
+	ErrSchemaNotFound   = errors.New("registered schema version not found")
+)
+
+// AvroCodec encodes and decodes values with an Avro schema.
+// Implement it with an adapter around an Avro library such as github.com/hamba/avro or github.com/linkedin/goavro.
+type AvroCodec interface {
+	Marshal(schema string, v interface{}) ([]byte, error)
+	Unmarshal(schema string, data []byte, v interface{}) error
+}
+
+// SchemaResolver fetches schema content by global ID.
+// It is satisfied by both *apis.VersionsAPI and *apis.CachingVersionsAPI.
+type SchemaResolver interface {
+	GetVersionContentByGlobalID(ctx context.Context, globalID int64, params *models.ArtifactReferenceParams) (*models.ArtifactContent, error)
+}
+
+// ArtifactIDStrategy maps a topic name to the artifact ID its schema is registered under.
+type ArtifactIDStrategy func(topic string) string
+
+// TopicIDStrategy registers value schemas under "<topic>-value", matching the Confluent default.
+func TopicIDStrategy(topic string) string {
+	return topic + "-value"
+}
+
+// AvroSerializer encodes values with an Avro schema and prefixes them with the schema's global ID.
+// Schemas are registered on first use and their global IDs are cached for the lifetime of the serializer.
+type AvroSerializer struct {
+	Artifacts  *apis.ArtifactsAPI
+	Codec      AvroCodec
+	GroupID    string
+	ArtifactID ArtifactIDStrategy
+
+	mu        sync.Mutex
+	globalIDs map[string]int64
+}
+
+// NewAvroSerializer creates a new AvroSerializer that registers schemas in the given group using TopicIDStrategy.
+func NewAvroSerializer(artifacts *apis.ArtifactsAPI, codec AvroCodec, groupID string) *AvroSerializer {
+	return &AvroSerializer{
+		Artifacts:  artifacts,
+		Codec:      codec,
+		GroupID:    groupID,
+		ArtifactID: TopicIDStrategy,
+		globalIDs:  make(map[string]int64),
+	}
+}
+
+// Serialize encodes v with the given schema, registering the schema for the topic if necessary.
+func (s *AvroSerializer) Serialize(ctx context.Context, topic, schema string, v interface{}) ([]byte, error) {
+	globalID, err := s.globalID(ctx, s.ArtifactID(topic), schema)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := s.Codec.Marshal(schema, v)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode value")
+	}
+
+	message := make([]byte, headerSize, headerSize+len(payload))
+	message[0] = MagicByte
+	binary.BigEndian.PutUint64(message[1:headerSize], uint64(globalID))
+
+	return append(message, payload...), nil
+}
+
+// globalID returns the global ID of the schema, registering it on a cache miss.
+func (s *AvroSerializer) globalID(ctx context.Context, artifactID, schema string) (int64, error) {
+	key := artifactID + "\x00" + schema
+
+	s.mu.Lock()
+	globalID, ok := s.globalIDs[key]
+	s.mu.Unlock()
+	if ok {
+		return globalID, nil
+	}
+
+	globalID, err := s.register(ctx, artifactID, schema)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	s.globalIDs[key] = globalID
+	s.mu.Unlock()
+
+	return globalID, nil
+}
+
+// register creates the artifact (or a new version of it) unless a version with the same content
+// already exists, and returns the global ID of the created or matching version.
+func (s *AvroSerializer) register(ctx context.Context, artifactID, schema string) (int64, error) {
+	artifact := models.CreateArtifactRequest{
+		ArtifactID:   artifactID,
+		ArtifactType: models.Avro,
+		FirstVersion: models.CreateVersionRequest{
+			Content: models.CreateContentRequest{
+				Content:     schema,
+				ContentType: apis.ContentTypeJSON,
+			},
+		},
+	}
+	params := &models.CreateArtifactParams{
+		IfExists:  models.IfExistsFindOrCreateVersion,
+		Canonical: true,
+	}
+	response, err := s.Artifacts.CreateArtifactWithVersion(ctx, s.GroupID, artifact, params)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to register schema for artifact %s", artifactID)
+	}
+	if response.Version.GlobalID == 0 {
+		return 0, errors.Wrapf(ErrSchemaNotFound, "artifact %s", artifactID)
+	}
+
+	return response.Version.GlobalID, nil
+}
+
+// AvroDeserializer decodes messages produced by AvroSerializer, resolving the writer schema by its global ID.
+type AvroDeserializer struct {
+	Resolver SchemaResolver
+	Codec    AvroCodec
+}
+
+// NewAvroDeserializer creates a new AvroDeserializer.
+// Pass an *apis.CachingVersionsAPI as the resolver to avoid fetching the same schema for every message.
+func NewAvroDeserializer(resolver SchemaResolver, codec AvroCodec) *AvroDeserializer {
+	return &AvroDeserializer{
+		Resolver: resolver,
+		Codec:    codec,
+	}
+}
+
+// Deserialize decodes the message into v.
+func (d *AvroDeserializer) Deserialize(ctx context.Context, message []byte, v interface{}) error {
+	globalID, payload, err := ParseHeader(message)
+	if err != nil {
+		return err
+	}
+
+	schema, err := d.Resolver.GetVersionContentByGlobalID(ctx, globalID, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to resolve schema for global ID %d", globalID)
+	}
+
+	if err := d.Codec.Unmarshal(schema.Content, payload, v); err != nil {
+		return errors.Wrap(err, "failed to decode value")
+	}
+
+	return nil
+}
+
+// ParseHeader splits a message into the global ID of its writer schema and the encoded payload.
+func ParseHeader(message []byte) (int64, []byte, error) {
+	if len(message) < headerSize {
+		return 0, nil, ErrMessageTooShort
+	}
+	if message[0] != MagicByte {
+		return 0, nil, errors.Wrapf(ErrInvalidMagicByte, "got 0x%x", message[0])
+	}
+
+	return int64(binary.BigEndian.Uint64(message[1:headerSize])), message[headerSize:], nil
+}
@@ -0,0 +1,173 @@
+package serde_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/subzerobo/go-apicurio-sdk/apis"
+	"github.com/subzerobo/go-apicurio-sdk/client"
+	"github.com/subzerobo/go-apicurio-sdk/models"
+	"github.com/subzerobo/go-apicurio-sdk/serde"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const stubSchema = `{"type":"record","name":"User","fields":[{"name":"name","type":"string"},{"name":"age","type":"int"}]}`
+
+type user struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+// jsonCodec stands in for a real Avro library; the serde wiring does not depend on the encoding.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(_ string, v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(_ string, data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// mockRegistry is a minimal in-memory registry that assigns a global ID per distinct schema.
+type mockRegistry struct {
+	mu        sync.Mutex
+	globalIDs map[string]int64
+	schemas   map[int64]string
+	creates   int
+	lookups   int
+}
+
+func newMockRegistry() *mockRegistry {
+	return &mockRegistry{
+		globalIDs: make(map[string]int64),
+		schemas:   make(map[int64]string),
+	}
+}
+
+func (m *mockRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/groups/test-group/artifacts":
+		m.creates++
+		var request models.CreateArtifactRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		schema := request.FirstVersion.Content.Content
+		if _, ok := m.globalIDs[schema]; !ok {
+			globalID := int64(len(m.globalIDs) + 100)
+			m.globalIDs[schema] = globalID
+			m.schemas[globalID] = schema
+		}
+		_ = json.NewEncoder(w).Encode(models.CreateArtifactResponse{
+			Artifact: models.ArtifactDetail{GroupID: "test-group", ArtifactID: request.ArtifactID},
+			Version: models.ArtifactVersionDetailed{ArtifactVersion: models.ArtifactVersion{
+				GlobalID:   m.globalIDs[schema],
+				ArtifactID: request.ArtifactID,
+			}},
+		})
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/ids/globalIds/"):
+		m.lookups++
+		globalID, _ := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, "/ids/globalIds/"), 10, 64)
+		schema, ok := m.schemas[globalID]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("X-Registry-ArtifactType", string(models.Avro))
+		_, _ = w.Write([]byte(schema))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestAvroSerDe_RoundTrip(t *testing.T) {
+	registry := newMockRegistry()
+	server := httptest.NewServer(registry)
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	serializer := serde.NewAvroSerializer(apis.NewArtifactsAPI(mockClient), jsonCodec{}, "test-group")
+	deserializer := serde.NewAvroDeserializer(apis.NewVersionsAPI(mockClient), jsonCodec{})
+
+	ctx := context.Background()
+	message, err := serializer.Serialize(ctx, "users", stubSchema, user{Name: "Ada", Age: 36})
+	assert.NoError(t, err)
+	assert.Equal(t, serde.MagicByte, message[0])
+
+	globalID, _, err := serde.ParseHeader(message)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), globalID)
+
+	var decoded user
+	err = deserializer.Deserialize(ctx, message, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, user{Name: "Ada", Age: 36}, decoded)
+
+	// The schema is registered once per serializer.
+	_, err = serializer.Serialize(ctx, "users", stubSchema, user{Name: "Grace", Age: 45})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, registry.creates)
+}
+
+func TestAvroSerDe_CachingResolver(t *testing.T) {
+	registry := newMockRegistry()
+	server := httptest.NewServer(registry)
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	serializer := serde.NewAvroSerializer(apis.NewArtifactsAPI(mockClient), jsonCodec{}, "test-group")
+	deserializer := serde.NewAvroDeserializer(apis.NewCachingVersionsAPI(mockClient, 10, 0), jsonCodec{})
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		message, err := serializer.Serialize(ctx, "users", stubSchema, user{Name: fmt.Sprintf("user-%d", i), Age: i})
+		assert.NoError(t, err)
+
+		var decoded user
+		assert.NoError(t, deserializer.Deserialize(ctx, message, &decoded))
+		assert.Equal(t, i, decoded.Age)
+	}
+
+	assert.Equal(t, 1, registry.lookups)
+}
+
+func TestAvroSerializer_MissingGlobalID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/groups/test-group/artifacts", r.URL.Path)
+		_ = json.NewEncoder(w).Encode(models.CreateArtifactResponse{
+			Artifact: models.ArtifactDetail{GroupID: "test-group", ArtifactID: "users-value"},
+		})
+	}))
+	defer server.Close()
+
+	mockClient := &client.Client{BaseURL: server.URL, HTTPClient: server.Client()}
+	serializer := serde.NewAvroSerializer(apis.NewArtifactsAPI(mockClient), jsonCodec{}, "test-group")
+
+	_, err := serializer.Serialize(context.Background(), "users", stubSchema, user{Name: "Ada", Age: 36})
+	assert.ErrorIs(t, err, serde.ErrSchemaNotFound)
+}
+
+func TestAvroDeserializer_InvalidMessage(t *testing.T) {
+	deserializer := serde.NewAvroDeserializer(nil, jsonCodec{})
+
+	t.Run("TooShort", func(t *testing.T) {
+		err := deserializer.Deserialize(context.Background(), []byte{0x0, 0x1}, &user{})
+		assert.ErrorIs(t, err, serde.ErrMessageTooShort)
+	})
+
+	t.Run("InvalidMagicByte", func(t *testing.T) {
+		err := deserializer.Deserialize(context.Background(), []byte{0x1, 0, 0, 0, 0, 0, 0, 0, 1, '{', '}'}, &user{})
+		assert.ErrorIs(t, err, serde.ErrInvalidMagicByte)
+	})
+}